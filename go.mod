@@ -4,10 +4,12 @@ go 1.25.3
 
 require (
 	github.com/hashicorp/terraform-plugin-framework v1.16.1
+	github.com/hashicorp/terraform-plugin-framework-timeouts v0.4.1
 	github.com/hashicorp/terraform-plugin-go v0.29.0
 	github.com/hashicorp/terraform-plugin-log v0.9.0
 	github.com/hashicorp/terraform-plugin-testing v1.13.3
 	github.com/leefowlercu/go-contextforge v0.8.1
+	golang.org/x/time v0.15.0
 )
 
 require (
@@ -186,21 +186,13 @@ func (d *promptDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Get prompt using List and filter (no Get metadata method)
-	prompts, _, err := d.client.Prompts.List(ctx, &contextforge.PromptListOptions{IncludeInactive: true})
+	targetID := data.ID.ValueString()
+	prompt, err := findPrompt(ctx, d.client, targetID)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to List Prompts", fmt.Sprintf("Unable to list prompts; %v", err))
 		return
 	}
 
-	var prompt *contextforge.Prompt
-	targetID := data.ID.ValueString()
-	for _, p := range prompts {
-		if p.ID == targetID {
-			prompt = p
-			break
-		}
-	}
-
 	if prompt == nil {
 		resp.Diagnostics.AddError("Prompt Not Found", fmt.Sprintf("Unable to find prompt with ID %s", targetID))
 		return
@@ -315,16 +307,16 @@ func (d *promptDataSource) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*contextforge.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *contextforge.Client, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
 }
 
 func (m promptArgumentModel) attrTypes() map[string]attr.Type {
@@ -472,17 +472,17 @@ func (d *serverDataSource) Configure(ctx context.Context, req datasource.Configu
 	}
 
 	// Type assert the provider data to the expected client type
-	client, ok := req.ProviderData.(*contextforge.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *contextforge.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	// Assign the client to the data source
-	d.client = client
+	d.client = data.Client
 }
 
 // attrTypes returns the attribute types map for serverMetricsModel.
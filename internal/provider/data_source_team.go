@@ -7,13 +7,15 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/leefowlercu/go-contextforge/contextforge"
 	"github.com/leefowlercu/terraform-provider-contextforge/internal/tfconv"
 )
 
 type teamDataSource struct {
-	client *contextforge.Client
+	client   *contextforge.Client
+	features gatewayFeatures
 }
 
 // Force compile-time validation that teamDataSource satisfies the datasource.DataSource interface.
@@ -148,24 +150,8 @@ func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	// Get team using List and filter (Get endpoint has authentication issues in v0.8.0)
-	teams, _, err := d.client.Teams.List(ctx, nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to List Teams", fmt.Sprintf("Unable to list teams; %v", err))
-		return
-	}
-
-	var team *contextforge.Team
-	targetID := data.ID.ValueString()
-	for _, t := range teams {
-		if t.ID == targetID {
-			team = t
-			break
-		}
-	}
-
-	if team == nil {
-		resp.Diagnostics.AddError("Team Not Found", fmt.Sprintf("Unable to find team with ID %s", targetID))
+	team, ok := d.getTeam(ctx, data.ID.ValueString(), &resp.Diagnostics)
+	if !ok {
 		return
 	}
 
@@ -214,15 +200,32 @@ func (d *teamDataSource) Configure(ctx context.Context, req datasource.Configure
 	}
 
 	// Type assert the provider data to the expected client type
-	client, ok := req.ProviderData.(*contextforge.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *contextforge.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	// Assign the client to the data source
-	d.client = client
+	d.client = data.Client
+	d.features = data.Features
+}
+
+// getTeam looks up a team by ID, adding an error diagnostic when it cannot be found.
+func (d *teamDataSource) getTeam(ctx context.Context, teamID string, diags *diag.Diagnostics) (*contextforge.Team, bool) {
+	team, err := findTeam(ctx, d.client, d.features, teamID)
+	if err != nil {
+		diags.AddError("Failed to Read Team", fmt.Sprintf("Unable to read team with ID %s; %v", teamID, err))
+		return nil, false
+	}
+
+	if team == nil {
+		diags.AddError("Team Not Found", fmt.Sprintf("Unable to find team with ID %s", teamID))
+		return nil, false
+	}
+
+	return team, true
 }
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+type userDataSource struct {
+	client *contextforge.Client
+}
+
+// Force compile-time validation that userDataSource satisfies the datasource.DataSource interface.
+var _ datasource.DataSource = &userDataSource{}
+
+// Force compile-time validation that userDataSource satisfies the datasource.DataSourceWithConfigure interface.
+var _ datasource.DataSourceWithConfigure = &userDataSource{}
+
+// userDataSourceModel defines the data source model.
+type userDataSourceModel struct {
+	// Lookup field
+	Email types.String `tfsdk:"email"`
+
+	// Core fields
+	ID            types.String `tfsdk:"id"`
+	FullName      types.String `tfsdk:"full_name"`
+	IsAdmin       types.Bool   `tfsdk:"is_admin"`
+	IsActive      types.Bool   `tfsdk:"is_active"`
+	AuthProvider  types.String `tfsdk:"auth_provider"`
+	EmailVerified types.Bool   `tfsdk:"email_verified"`
+
+	// Timestamps
+	CreatedAt types.String `tfsdk:"created_at"`
+	LastLogin types.String `tfsdk:"last_login"`
+}
+
+// NewUserDataSource is a helper function to instantiate the user data source.
+func NewUserDataSource() datasource.DataSource {
+	return &userDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *userDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the data source.
+func (d *userDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source for looking up a ContextForge user by email address",
+		Description:         "Data source for looking up a ContextForge user by email address",
+
+		Attributes: map[string]schema.Attribute{
+			// Lookup field
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address for lookup",
+				Description:         "Email address for lookup",
+				Required:            true,
+			},
+
+			// Core fields
+			"id": schema.StringAttribute{
+				MarkdownDescription: "User identifier (the email address)",
+				Description:         "User identifier (the email address)",
+				Computed:            true,
+			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "Full name of the user",
+				Description:         "Full name of the user",
+				Computed:            true,
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is a platform administrator",
+				Description:         "Whether the user is a platform administrator",
+				Computed:            true,
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the account is active",
+				Description:         "Whether the account is active",
+				Computed:            true,
+			},
+			"auth_provider": schema.StringAttribute{
+				MarkdownDescription: "Authentication provider of the account",
+				Description:         "Authentication provider of the account",
+				Computed:            true,
+			},
+			"email_verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the email address has been verified",
+				Description:         "Whether the email address has been verified",
+				Computed:            true,
+			},
+
+			// Timestamps
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp (RFC3339 format)",
+				Description:         "Creation timestamp (RFC3339 format)",
+				Computed:            true,
+			},
+			"last_login": schema.StringAttribute{
+				MarkdownDescription: "Last login timestamp (RFC3339 format)",
+				Description:         "Last login timestamp (RFC3339 format)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data userDataSourceModel
+
+	// Read configuration
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := data.Email.ValueString()
+
+	user, httpResp, err := getUser(ctx, d.client, email)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.Diagnostics.AddError(
+				"User Not Found",
+				fmt.Sprintf("Unable to find user with email %s", email),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Read User",
+			fmt.Sprintf("Unable to read user with email %s; %v", email, err),
+		)
+		return
+	}
+
+	// Map core fields
+	data.ID = types.StringValue(user.Email)
+	data.FullName = types.StringPointerValue(user.FullName)
+	data.IsAdmin = types.BoolValue(user.IsAdmin)
+	data.IsActive = types.BoolValue(user.IsActive)
+	data.AuthProvider = types.StringValue(user.AuthProvider)
+	data.EmailVerified = types.BoolValue(user.EmailVerified)
+
+	// Map timestamps (convert to RFC3339 string)
+	if user.CreatedAt != nil && !user.CreatedAt.Time.IsZero() {
+		data.CreatedAt = types.StringValue(user.CreatedAt.Time.Format(time.RFC3339))
+	} else {
+		data.CreatedAt = types.StringNull()
+	}
+
+	if user.LastLogin != nil && !user.LastLogin.Time.IsZero() {
+		data.LastLogin = types.StringValue(user.LastLogin.Time.Format(time.RFC3339))
+	} else {
+		data.LastLogin = types.StringNull()
+	}
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *userDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// Type assert the provider data to the expected client type
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	// Assign the client to the data source
+	d.client = data.Client
+}
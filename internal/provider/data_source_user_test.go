@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccUserDataSource_basic tests successful user lookup by email address.
+// This test verifies that the data source can retrieve a user and populate
+// all expected attributes.
+//
+// Prerequisites:
+//   - CONTEXTFORGE_ADDR environment variable set
+//   - CONTEXTFORGE_TOKEN environment variable set
+//   - Integration test setup completed (creates the member@test.local user)
+//
+// To run:
+//   make integration-test-all  # Full lifecycle with setup/teardown
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccUserDataSource_basic
+func TestAccUserDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccUserDataSourceConfig("member@test.local"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify lookup attributes
+					resource.TestCheckResourceAttr("data.contextforge_user.test", "email", "member@test.local"),
+					resource.TestCheckResourceAttr("data.contextforge_user.test", "id", "member@test.local"),
+
+					// Verify account attributes
+					resource.TestCheckResourceAttr("data.contextforge_user.test", "is_admin", "false"),
+					resource.TestCheckResourceAttr("data.contextforge_user.test", "is_active", "true"),
+					resource.TestCheckResourceAttrSet("data.contextforge_user.test", "auth_provider"),
+
+					// Verify timestamp attributes are populated
+					resource.TestCheckResourceAttrSet("data.contextforge_user.test", "created_at"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccUserDataSource_nonExistent tests error handling for a non-existent email address.
+//
+// To run:
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccUserDataSource_nonExistent
+func TestAccUserDataSource_nonExistent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccUserDataSourceConfig("non-existent-user@test.local"),
+				ExpectError: regexp.MustCompile(`User Not Found|Unable to find user`),
+			},
+		},
+	})
+}
+
+// testAccUserDataSourceConfig returns the Terraform configuration for user lookup by email.
+//
+// Parameters:
+//   - email: The email address of the user to look up
+//
+// Returns:
+//   - HCL configuration string with the data source definition
+func testAccUserDataSourceConfig(email string) string {
+	return fmt.Sprintf(`
+data "contextforge_user" "test" {
+  email = %[1]q
+}
+`, email)
+}
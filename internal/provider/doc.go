@@ -6,11 +6,42 @@
 //
 // # Provider Configuration
 //
-// The provider supports dual-source configuration for address and token:
+// The provider supports dual-source configuration for address and credentials:
 //
-//   - Environment variables (CONTEXTFORGE_ADDR, CONTEXTFORGE_TOKEN) provide defaults
+//   - Environment variables (CONTEXTFORGE_ADDR, CONTEXTFORGE_TOKEN, CONTEXTFORGE_TOKEN_FILE,
+//     CONTEXTFORGE_TOKEN_COMMAND, CONTEXTFORGE_EMAIL, CONTEXTFORGE_PASSWORD) provide defaults
 //   - HCL configuration attributes override environment variables
+//   - profile (or CONTEXTFORGE_PROFILE) selects a connection profile from ~/.contextforge/config
+//     or CONTEXTFORGE_CONFIG_FILE; it sits between the configuration and the environment in the
+//     precedence chain (see provider_profile.go)
 //   - Validation occurs in two phases: unknown value detection and empty value validation
+//   - address must be an http(s) URL without a path; base_path adds a path prefix for
+//     gateways behind path-based ingress (see provider_address.go)
+//   - The credential methods (token, token_file, token_command, email/password) are mutually
+//     exclusive; all but a static token are re-run to refresh an expiring token, and
+//     email/password logs in via POST /auth/email/login (see provider_auth.go)
+//   - request_timeout bounds each request attempt; resource timeouts blocks bound whole operations
+//   - Transient failures (connection errors, 429, 502, 503, 504) are retried with exponential
+//     backoff honouring Retry-After; non-idempotent requests only when the gateway cannot have
+//     processed them (see provider_transport.go)
+//   - requests_per_second and max_concurrent_requests throttle every request attempt made
+//     through one provider configuration
+//   - proxy_url routes requests through a proxy and headers adds custom headers to every request
+//   - default_tags are merged into the tags of every taggable resource; the computed tags_all
+//     attribute holds the effective tags (see provider_tags.go)
+//   - default_team_id and default_visibility are planned for team-scoped resources created
+//     without team_id or visibility (see provider_defaults.go)
+//   - read_only makes every resource Create, Update and Delete fail with a diagnostic before
+//     calling the API; reads and data sources are unaffected (see provider_read_only.go)
+//   - Unless skip_connectivity_check is set, Configure checks GET /health before using the
+//     credentials and GET /version after, reporting an unreachable gateway, a rejected token
+//     or an unsupported version as a single diagnostic (see provider_handshake.go)
+//   - The reported version selects entries of the capability table in provider_capabilities.go;
+//     an unknown version is treated as the oldest supported one
+//   - HTTP exchanges are logged under the contextforge tflog subsystem with secrets redacted
+//     (see provider_logging.go)
+//   - The optional tls block configures a private CA bundle, a client certificate for
+//     mutual TLS, or disables certificate verification (see provider_tls.go)
 //
 // Configuration example:
 //
@@ -19,8 +50,9 @@
 //	  token   = var.contextforge_token
 //	}
 //
-// The Configure() method creates a contextforge.Client and stores it in both
-// resp.DataSourceData and resp.ResourceData for downstream data sources and resources.
+// The Configure() method creates a contextforge.Client and stores it, wrapped in a
+// *providerData, in both resp.DataSourceData and resp.ResourceData for downstream
+// data sources and resources.
 //
 // # Data Source Implementation Pattern
 //
@@ -141,6 +173,10 @@
 //	    // ... map to model and save state ...
 //	}
 //
+// Version-specific quirks are recorded as capabilities in provider_capabilities.go. Code that
+// works around a quirk checks features.supports(capability) and keeps the workaround as the
+// fallback, so that setting the capability's since version enables the direct code path.
+//
 // # Resource Implementation Pattern
 //
 // Resources manage the lifecycle of ContextForge objects (create, read, update, delete).
@@ -152,25 +188,36 @@
 //  4. Optionally implement ImportState for terraform import support
 //  5. Register in provider's Resources() method
 //
+// Long-running resources add a timeouts block (terraform-plugin-framework-timeouts) and wrap
+// the context of each CRUD method with context.WithTimeout, using the default*Timeout constants
+// from provider.go when the block does not set a duration.
+//
+// Taggable resources add a computed tags_all attribute, send requestTags() in Create and
+// Update, map API tags with stateTags() and implement ModifyPlan with modifyPlanTagsAll(),
+// so that tags inherited from default_tags never show up as a diff on tags. Their ModifyPlan
+// also calls modifyPlanTeamDefaults() to apply the provider default team and visibility.
+//
 // # Client Access Pattern
 //
-// Data sources and resources access the ContextForge client via type assertion:
+// Configure() wraps the client in a *providerData together with provider-level settings
+// (such as default_tags) and stores it in resp.DataSourceData and resp.ResourceData.
+// Data sources and resources access it via type assertion:
 //
 //	func (d *gatewayDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 //	    if req.ProviderData == nil {
 //	        return
 //	    }
 //
-//	    client, ok := req.ProviderData.(*contextforge.Client)
+//	    data, ok := req.ProviderData.(*providerData)
 //	    if !ok {
 //	        resp.Diagnostics.AddError(
 //	            "Unexpected Data Source Configure Type",
-//	            fmt.Sprintf("Expected *contextforge.Client, got: %T", req.ProviderData),
+//	            fmt.Sprintf("Expected *providerData, got: %T", req.ProviderData),
 //	        )
 //	        return
 //	    }
 //
-//	    d.client = client
+//	    d.client = data.Client
 //	}
 //
 // # Testing Organization
@@ -180,6 +227,8 @@
 //   - provider_test.go - Shared test infrastructure (testAccProtoV6ProviderFactories, testAccPreCheck)
 //   - data_source_<name>_test.go - Acceptance tests for specific data sources
 //   - resource_<name>_test.go - Acceptance tests for specific resources
+//   - provider_<topic>_test.go - Unit tests for provider helpers (authentication, TLS,
+//     transports) against httptest servers; these run without TF_ACC
 //
 // Test utilities are unexported (lowercase) as they're package-internal.
 //
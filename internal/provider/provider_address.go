@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// parseAddress validates the address setting, which must be an http or https URL with a
+// host, an optional port and no path, query or credentials.
+func parseAddress(raw string) (*url.URL, error) {
+	address, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, fmt.Errorf("address %q is not a valid URL; %w", raw, err)
+	}
+
+	// Without a scheme, "localhost:8000" parses as scheme "localhost" with opaque data "8000"
+	if address.Scheme != "http" && address.Scheme != "https" {
+		if address.Host == "" {
+			return nil, fmt.Errorf("address %q must include the http:// or https:// scheme, e.g. \"http://%s\"", raw, strings.TrimPrefix(raw, "//"))
+		}
+		return nil, fmt.Errorf("address %q must use the http or https scheme, got %q", raw, address.Scheme)
+	}
+
+	if address.Hostname() == "" {
+		return nil, fmt.Errorf("address %q must include a hostname", raw)
+	}
+
+	if port := address.Port(); port != "" {
+		if n, err := strconv.Atoi(port); err != nil || n < 1 || n > 65535 {
+			return nil, fmt.Errorf("address %q has an invalid port %q; the port must be between 1 and 65535", raw, port)
+		}
+	}
+
+	if address.User != nil {
+		return nil, fmt.Errorf("address %q must not include credentials; use token, token_file, token_command or email and password", raw)
+	}
+
+	if address.RawQuery != "" || address.Fragment != "" {
+		return nil, fmt.Errorf("address %q must not include a query or fragment", raw)
+	}
+
+	if strings.Trim(address.Path, "/") != "" {
+		return nil, fmt.Errorf("address %q must not include a path; set base_path = %q instead", raw, address.Path)
+	}
+
+	address.Path = ""
+	address.RawPath = ""
+	return address, nil
+}
+
+// normalizeBasePath validates the base_path setting and returns it with a leading and a
+// trailing slash, or "/" when basePath is empty.
+func normalizeBasePath(basePath string) (string, error) {
+	trimmed := strings.Trim(strings.TrimSpace(basePath), "/")
+	if trimmed == "" {
+		return "/", nil
+	}
+
+	if strings.ContainsAny(trimmed, "?#") {
+		return "", fmt.Errorf("base_path %q must not include a query or fragment", basePath)
+	}
+
+	for _, segment := range strings.Split(trimmed, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", fmt.Errorf("base_path %q must not include empty, \".\" or \"..\" segments", basePath)
+		}
+	}
+
+	return "/" + trimmed + "/", nil
+}
+
+// clientAddress joins a validated address and base path into the address passed to
+// contextforge.NewClient. The go-contextforge client resolves API paths relative to it.
+func clientAddress(address *url.URL, basePath string) string {
+	u := *address
+	u.Path = basePath
+	return u.String()
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+// postAdminForm submits a form to an endpoint of the gateway's admin API (under /admin),
+// which backs the admin UI. It is used for operations the REST API does not offer, and
+// requires MCPGATEWAY_ADMIN_API_ENABLED on the gateway. The endpoints respond with HTML
+// fragments, which are discarded, so callers read the changed object back to confirm
+// the operation.
+func postAdminForm(ctx context.Context, client *contextforge.Client, urlStr string, form url.Values) (*contextforge.Response, error) {
+	req, err := client.NewRequest(http.MethodPost, urlStr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body := []byte(form.Encode())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	req.ContentLength = int64(len(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return client.Do(ctx, req, io.Discard)
+}
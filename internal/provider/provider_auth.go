@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+// tokenRefreshWindow is how long before a token's expiry the provider proactively
+// obtains a new token, so that requests in flight never race the expiry.
+const tokenRefreshWindow = 60 * time.Second
+
+// providerCredentials holds the credential settings taken from a single configuration
+// source, either the provider configuration block or the environment.
+type providerCredentials struct {
+	Token        string
+	TokenFile    string
+	TokenCommand []string
+	Email        string
+	Password     string
+}
+
+// credentialsFromEnv reads the credential settings from environment variables.
+func credentialsFromEnv() providerCredentials {
+	return providerCredentials{
+		Token:        os.Getenv("CONTEXTFORGE_TOKEN"),
+		TokenFile:    os.Getenv("CONTEXTFORGE_TOKEN_FILE"),
+		TokenCommand: strings.Fields(os.Getenv("CONTEXTFORGE_TOKEN_COMMAND")),
+		Email:        os.Getenv("CONTEXTFORGE_EMAIL"),
+		Password:     os.Getenv("CONTEXTFORGE_PASSWORD"),
+	}
+}
+
+// methods returns the names of the credential methods set in c.
+func (c providerCredentials) methods() []string {
+	var methods []string
+	if c.Token != "" {
+		methods = append(methods, "token")
+	}
+	if c.TokenFile != "" {
+		methods = append(methods, "token_file")
+	}
+	if len(c.TokenCommand) > 0 {
+		methods = append(methods, "token_command")
+	}
+	if c.Email != "" || c.Password != "" {
+		methods = append(methods, "email/password")
+	}
+	return methods
+}
+
+// tokenRefresher returns a function that obtains a token using the credential method set in c,
+// or nil when c holds a static token that cannot be refreshed. authClient is used for login
+// requests and must not route through the provider's token-injecting transport.
+func (c providerCredentials) tokenRefresher(authClient *contextforge.Client) tokenRefreshFunc {
+	switch {
+	case c.Token != "":
+		return nil
+	case c.TokenFile != "":
+		return func(ctx context.Context) (string, error) {
+			tflog.Debug(ctx, "Reading ContextForge token from file", map[string]any{"token_file": c.TokenFile})
+			return readTokenFile(c.TokenFile)
+		}
+	case len(c.TokenCommand) > 0:
+		return func(ctx context.Context) (string, error) {
+			tflog.Debug(ctx, "Running ContextForge token command", map[string]any{"token_command": c.TokenCommand[0]})
+			return runTokenCommand(ctx, c.TokenCommand)
+		}
+	default:
+		return func(ctx context.Context) (string, error) {
+			tflog.Debug(ctx, "Logging in to ContextForge with email and password", map[string]any{"email": c.Email})
+			return loginWithPassword(ctx, authClient, c.Email, c.Password)
+		}
+	}
+}
+
+// readTokenFile reads a token from the file at path, ignoring surrounding whitespace.
+func readTokenFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file; %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+
+	return token, nil
+}
+
+// runTokenCommand runs an external credential command and returns the token it prints.
+//
+// The command may print either the raw token or a JSON object carrying the token in a
+// "token" or "access_token" field, or in "status.token" as Kubernetes exec credential
+// plugins do.
+func runTokenCommand(ctx context.Context, argv []string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, argv[0], argv[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("token command %q failed; %w: %s", argv[0], err, msg)
+		}
+		return "", fmt.Errorf("token command %q failed; %w", argv[0], err)
+	}
+
+	output := strings.TrimSpace(stdout.String())
+	if strings.HasPrefix(output, "{") {
+		var credential struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+			Status      struct {
+				Token string `json:"token"`
+			} `json:"status"`
+		}
+		if err := json.Unmarshal([]byte(output), &credential); err != nil {
+			return "", fmt.Errorf("failed to parse token command output as JSON; %w", err)
+		}
+
+		for _, token := range []string{credential.Token, credential.AccessToken, credential.Status.Token} {
+			if token != "" {
+				return token, nil
+			}
+		}
+		return "", fmt.Errorf("token command %q printed JSON without a token, access_token or status.token field", argv[0])
+	}
+
+	if output == "" {
+		return "", fmt.Errorf("token command %q printed no token", argv[0])
+	}
+
+	return output, nil
+}
+
+// emailLoginRequest is the request body for the ContextForge email login endpoint.
+type emailLoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// emailLoginResponse is the response body returned by the ContextForge email login endpoint.
+type emailLoginResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+}
+
+// loginWithPassword authenticates against the ContextForge email auth endpoint
+// (POST /auth/email/login) and returns the JWT minted by the gateway.
+//
+// The supplied client is only used for request construction and transport; it
+// does not need to carry a bearer token.
+func loginWithPassword(ctx context.Context, client *contextforge.Client, email, password string) (string, error) {
+	req, err := client.NewRequest(http.MethodPost, "auth/email/login", &emailLoginRequest{
+		Email:    email,
+		Password: password,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to build login request; %w", err)
+	}
+
+	// The login endpoint must never receive a stale bearer token
+	req.Header.Del("Authorization")
+
+	var loginResp emailLoginResponse
+	resp, err := client.Do(ctx, req, &loginResp)
+	if err != nil {
+		var errResp *contextforge.ErrorResponse
+		if errors.As(err, &errResp) && resp != nil &&
+			(resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden) {
+			return "", fmt.Errorf("the gateway rejected the email/password credentials (status %d)", resp.StatusCode)
+		}
+		return "", fmt.Errorf("login request failed; %w", err)
+	}
+
+	if loginResp.AccessToken == "" {
+		return "", errors.New("login response did not contain an access token")
+	}
+
+	return loginResp.AccessToken, nil
+}
+
+// tokenRefreshFunc obtains a fresh token from the provider's configured credentials.
+type tokenRefreshFunc func(ctx context.Context) (string, error)
+
+// jwtExpiry decodes the exp claim of a JWT without verifying its signature.
+// The boolean result is false when the token is not a JWT or carries no exp claim.
+func jwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	var claims struct {
+		Exp *float64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == nil {
+		return time.Time{}, false
+	}
+
+	return time.Unix(int64(*claims.Exp), 0), true
+}
+
+// authTransport is an http.RoundTripper that sets the bearer token on every request.
+// When a refresh function is available it re-authenticates shortly before the token
+// expires, and retries a request once after a 401 response with a freshly obtained token.
+type authTransport struct {
+	base    http.RoundTripper
+	refresh tokenRefreshFunc
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// newAuthTransport returns an authTransport seeded with the given token.
+// refresh may be nil, in which case the token is used as-is for the lifetime of the provider.
+func newAuthTransport(base http.RoundTripper, token string, refresh tokenRefreshFunc) *authTransport {
+	t := &authTransport{
+		base:    base,
+		refresh: refresh,
+	}
+	t.setToken(token)
+	return t
+}
+
+// setToken stores the token and its decoded expiry. Callers must hold t.mu or own t exclusively.
+func (t *authTransport) setToken(token string) {
+	t.token = token
+	t.expiry = time.Time{}
+	if exp, ok := jwtExpiry(token); ok {
+		t.expiry = exp
+	}
+}
+
+// currentToken returns the token to use for the next request, refreshing it first
+// when it is about to expire and credentials are available to do so.
+func (t *authTransport) currentToken(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.refresh != nil && !t.expiry.IsZero() && time.Until(t.expiry) < tokenRefreshWindow {
+		tflog.Debug(ctx, "ContextForge token is about to expire, refreshing", map[string]any{"expiry": t.expiry.Format(time.RFC3339)})
+		if err := t.refreshLocked(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	return t.token, nil
+}
+
+// refreshAfterUnauthorized obtains a new token after the gateway rejected staleToken.
+// If another request already replaced the stale token, that newer token is returned instead.
+func (t *authTransport) refreshAfterUnauthorized(ctx context.Context, staleToken string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != staleToken {
+		return t.token, nil
+	}
+
+	tflog.Debug(ctx, "ContextForge rejected the current token, refreshing")
+	if err := t.refreshLocked(ctx); err != nil {
+		return "", err
+	}
+
+	return t.token, nil
+}
+
+// refreshLocked obtains a new token via the refresh function. Callers must hold t.mu.
+func (t *authTransport) refreshLocked(ctx context.Context) error {
+	token, err := t.refresh(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to refresh ContextForge token; %w", err)
+	}
+	t.setToken(token)
+	return nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	token, err := t.currentToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(withBearerToken(req, token))
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || t.refresh == nil {
+		return resp, err
+	}
+
+	// A request body that cannot be replayed cannot be retried
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+
+	newToken, err := t.refreshAfterUnauthorized(ctx, token)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to refresh ContextForge token after 401 response", map[string]any{"error": err.Error()})
+		return resp, nil
+	}
+
+	retry := withBearerToken(req, newToken)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+
+	// Discard the rejected response before retrying
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	return t.base.RoundTrip(retry)
+}
+
+// withBearerToken returns a shallow copy of req with its Authorization header set to token.
+func withBearerToken(req *http.Request, token string) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r
+}
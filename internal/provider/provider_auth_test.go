@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+// TestLoginWithPassword verifies that the provider exchanges email/password
+// credentials for a JWT using the gateway's email auth endpoint.
+//
+// To run:
+//
+//	go test -v ./internal/provider/ -run TestLoginWithPassword
+func TestLoginWithPassword(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/auth/email/login" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got := r.Header.Get("Authorization"); got != "" {
+			t.Errorf("login request should not carry an Authorization header, got %q", got)
+		}
+
+		var body emailLoginRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode login request; %v", err)
+		}
+
+		if body.Email != "admin@test.local" || body.Password != "testpassword123" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"detail":"Invalid email or password"}`))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"minted-token","token_type":"bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	client, err := contextforge.NewClient(nil, server.URL, "stale-token")
+	if err != nil {
+		t.Fatalf("failed to create client; %v", err)
+	}
+
+	t.Run("valid credentials", func(t *testing.T) {
+		token, err := loginWithPassword(context.Background(), client, "admin@test.local", "testpassword123")
+		if err != nil {
+			t.Fatalf("expected login to succeed; %v", err)
+		}
+		if token != "minted-token" {
+			t.Errorf("expected token %q, got %q", "minted-token", token)
+		}
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		_, err := loginWithPassword(context.Background(), client, "admin@test.local", "wrong")
+		if err == nil {
+			t.Fatal("expected login to fail")
+		}
+		if !strings.Contains(err.Error(), "rejected the email/password credentials") {
+			t.Errorf("unexpected error message: %v", err)
+		}
+	})
+}
+
+// testJWT builds an unsigned JWT carrying the given exp claim. The gateway
+// verifies signatures, but the provider only ever decodes the payload.
+func testJWT(t *testing.T, subject string, exp time.Time) string {
+	t.Helper()
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload, err := json.Marshal(map[string]any{"sub": subject, "exp": exp.Unix()})
+	if err != nil {
+		t.Fatalf("failed to marshal JWT payload; %v", err)
+	}
+
+	return header + "." + base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+}
+
+// TestJWTExpiry verifies decoding of the exp claim from JWTs and the handling of
+// tokens that are not JWTs.
+func TestJWTExpiry(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	got, ok := jwtExpiry(testJWT(t, "admin@test.local", exp))
+	if !ok {
+		t.Fatal("expected exp claim to be decoded")
+	}
+	if !got.Equal(exp) {
+		t.Errorf("expected expiry %s, got %s", exp, got)
+	}
+
+	if _, ok := jwtExpiry("not-a-jwt"); ok {
+		t.Error("expected opaque token to have no expiry")
+	}
+}
+
+// TestAuthTransport_refresh verifies that the auth transport refreshes a token
+// shortly before it expires and retries a request once after a 401 response.
+func TestAuthTransport_refresh(t *testing.T) {
+	var validToken atomic.Value
+	validToken.Store("")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+validToken.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	var refreshes int
+	refresh := func(ctx context.Context) (string, error) {
+		refreshes++
+		token := testJWT(t, fmt.Sprintf("refresh-%d", refreshes), time.Now().Add(time.Hour))
+		validToken.Store(token)
+		return token, nil
+	}
+
+	t.Run("expiring token", func(t *testing.T) {
+		refreshes = 0
+		expiring := testJWT(t, "expiring", time.Now().Add(10*time.Second))
+		validToken.Store(expiring)
+
+		client := &http.Client{Transport: newAuthTransport(http.DefaultTransport, expiring, refresh)}
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("request failed; %v", err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("expected status 200, got %d", resp.StatusCode)
+		}
+		if refreshes != 1 {
+			t.Errorf("expected 1 proactive refresh, got %d", refreshes)
+		}
+	})
+
+	t.Run("rejected token", func(t *testing.T) {
+		refreshes = 0
+		validToken.Store("revoked")
+
+		client := &http.Client{Transport: newAuthTransport(http.DefaultTransport, testJWT(t, "stale", time.Now().Add(time.Hour)), refresh)}
+		resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"replayed"}`))
+		if err != nil {
+			t.Fatalf("request failed; %v", err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("expected status 200 after retry, got %d", resp.StatusCode)
+		}
+		if string(body) != `{"name":"replayed"}` {
+			t.Errorf("expected request body to be replayed, got %q", body)
+		}
+		if refreshes != 1 {
+			t.Errorf("expected 1 refresh after 401, got %d", refreshes)
+		}
+	})
+
+	t.Run("no credentials", func(t *testing.T) {
+		validToken.Store("revoked")
+
+		client := &http.Client{Transport: newAuthTransport(http.DefaultTransport, "static-token", nil)}
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("request failed; %v", err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusUnauthorized {
+			t.Errorf("expected 401 to be returned unchanged, got %d", resp.StatusCode)
+		}
+	})
+}
+
+// TestReadTokenFile verifies reading a token from a file, including rejection of empty files.
+func TestReadTokenFile(t *testing.T) {
+	dir := t.TempDir()
+
+	tokenPath := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenPath, []byte("  file-token\n"), 0o600); err != nil {
+		t.Fatalf("failed to write token file; %v", err)
+	}
+
+	token, err := readTokenFile(tokenPath)
+	if err != nil {
+		t.Fatalf("expected token file to be read; %v", err)
+	}
+	if token != "file-token" {
+		t.Errorf("expected token %q, got %q", "file-token", token)
+	}
+
+	emptyPath := filepath.Join(dir, "empty")
+	if err := os.WriteFile(emptyPath, []byte("\n"), 0o600); err != nil {
+		t.Fatalf("failed to write token file; %v", err)
+	}
+
+	if _, err := readTokenFile(emptyPath); err == nil {
+		t.Error("expected empty token file to be rejected")
+	}
+
+	if _, err := readTokenFile(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected missing token file to be rejected")
+	}
+}
+
+// TestRunTokenCommand verifies parsing of raw and JSON token command output.
+//
+// To run:
+//
+//	go test -v ./internal/provider/ -run TestRunTokenCommand
+func TestRunTokenCommand(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not available")
+	}
+
+	tests := []struct {
+		name    string
+		script  string
+		want    string
+		wantErr string
+	}{
+		{name: "raw token", script: `echo raw-token`, want: "raw-token"},
+		{name: "token field", script: `echo '{"token":"json-token"}'`, want: "json-token"},
+		{name: "access_token field", script: `echo '{"access_token":"access-token"}'`, want: "access-token"},
+		{name: "exec credential", script: `echo '{"kind":"ExecCredential","status":{"token":"status-token"}}'`, want: "status-token"},
+		{name: "json without token", script: `echo '{"expires":1}'`, wantErr: "without a token"},
+		{name: "no output", script: `true`, wantErr: "printed no token"},
+		{name: "failure", script: `echo denied >&2; exit 1`, wantErr: "denied"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, err := runTokenCommand(context.Background(), []string{"sh", "-c", tt.script})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected command to succeed; %v", err)
+			}
+			if token != tt.want {
+				t.Errorf("expected token %q, got %q", tt.want, token)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// gatewayCapability is a gateway feature or behaviour that depends on the ContextForge version.
+type gatewayCapability int
+
+const (
+	// capabilityTeamGet is a working GET /teams/{team_id}. In 0.8.0 the endpoint fails with
+	// authentication errors for API tokens, so teams are looked up with List and filter.
+	capabilityTeamGet gatewayCapability = iota
+
+	// capabilityResourceUpdateResponse is a PUT /resources/{resource_id} response containing the
+	// complete resource. In 0.8.0 fields such as team_id are missing from it, so the resource
+	// is read again after an update.
+	capabilityResourceUpdateResponse
+)
+
+// capabilityInfo describes a capability in the capability table.
+type capabilityInfo struct {
+	// description names the capability in diagnostics.
+	description string
+
+	// since is the first ContextForge version with the capability. Empty when no release the
+	// provider has been verified against has it; the capability is then never used.
+	since string
+}
+
+// gatewayCapabilities is the capability table. When a release fixes a quirk, set since to
+// that release and the provider switches to the direct code path on gateways running it.
+var gatewayCapabilities = map[gatewayCapability]capabilityInfo{
+	capabilityTeamGet: {
+		description: "team lookup by ID (GET /teams/{team_id})",
+	},
+	capabilityResourceUpdateResponse: {
+		description: "complete resource in update responses (PUT /resources/{resource_id})",
+	},
+}
+
+// gatewayFeatures answers capability questions for the connected gateway.
+type gatewayFeatures struct {
+	// version is the gateway's ContextForge version, or empty when it is unknown.
+	version string
+}
+
+// newGatewayFeatures returns the features of a gateway running version. Versions that are
+// empty or cannot be parsed, for example when skip_connectivity_check is set, are treated
+// as minimumGatewayVersion so that code paths working on every supported release are used.
+func newGatewayFeatures(version string) gatewayFeatures {
+	if _, ok := parseVersion(version); !ok {
+		return gatewayFeatures{}
+	}
+	return gatewayFeatures{version: version}
+}
+
+// effectiveVersion returns the version capabilities are evaluated against.
+func (f gatewayFeatures) effectiveVersion() string {
+	if f.version == "" {
+		return minimumGatewayVersion
+	}
+	return f.version
+}
+
+// supports reports whether the connected gateway has capability c.
+func (f gatewayFeatures) supports(c gatewayCapability) bool {
+	info, ok := gatewayCapabilities[c]
+	if !ok || info.since == "" {
+		return false
+	}
+	return compareVersions(f.effectiveVersion(), info.since) >= 0
+}
+
+// warnUnsupported adds a warning diagnostic and returns true when the connected gateway does
+// not have capability c, for features the provider can only partially support without it.
+func (f gatewayFeatures) warnUnsupported(c gatewayCapability, diags *diag.Diagnostics) bool {
+	if f.supports(c) {
+		return false
+	}
+
+	info := gatewayCapabilities[c]
+	version := f.version
+	if version == "" {
+		version = "an unknown version"
+	}
+
+	detail := fmt.Sprintf("The ContextForge MCP Gateway runs %s, which does not support %s.", version, info.description)
+	if info.since != "" {
+		detail += fmt.Sprintf(" Upgrade to ContextForge %s or later to use it.", info.since)
+	}
+	diags.AddWarning("Unsupported ContextForge Feature", detail)
+
+	return true
+}
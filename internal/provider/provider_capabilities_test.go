@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// TestGatewayFeatures verifies capability lookups against the capability table.
+//
+// To run:
+//
+//	go test -v ./internal/provider/ -run TestGatewayFeatures
+func TestGatewayFeatures(t *testing.T) {
+	const capabilityTest gatewayCapability = -1
+	gatewayCapabilities[capabilityTest] = capabilityInfo{description: "test feature", since: "0.9.0"}
+	t.Cleanup(func() { delete(gatewayCapabilities, capabilityTest) })
+
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{version: "0.8.0", want: false},
+		{version: "0.9.0", want: true},
+		{version: "1.0.0-rc.1", want: true},
+		// Unknown versions are treated as the minimum supported version
+		{version: "", want: false},
+		{version: "development", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := newGatewayFeatures(tt.version).supports(capabilityTest); got != tt.want {
+			t.Errorf("version %q: expected supports = %t, got %t", tt.version, tt.want, got)
+		}
+	}
+
+	// Quirks without a fixed release are never treated as fixed
+	if newGatewayFeatures("99.0.0").supports(capabilityTeamGet) {
+		t.Error("expected capabilities without a since version to be unsupported")
+	}
+
+	var diags diag.Diagnostics
+	if !newGatewayFeatures("0.8.0").warnUnsupported(capabilityTest, &diags) {
+		t.Fatal("expected the capability to be reported as unsupported")
+	}
+	if diags.WarningsCount() != 1 || !strings.Contains(diags[0].Detail(), "Upgrade to ContextForge 0.9.0") {
+		t.Errorf("expected a warning naming the required version, got %v", diags)
+	}
+
+	diags = nil
+	if newGatewayFeatures("0.9.0").warnUnsupported(capabilityTest, &diags) || len(diags) != 0 {
+		t.Errorf("expected no warning for a supported capability, got %v", diags)
+	}
+}
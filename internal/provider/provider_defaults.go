@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// visibilities are the visibility levels accepted by the ContextForge API for team-scoped objects.
+var visibilities = []string{"private", "team", "public"}
+
+// modifyPlanTeamDefaults plans the provider's default_team_id and default_visibility for a
+// resource being created without team_id or visibility, so the plan shows the resolved
+// values and Create sends them. Existing objects are left in their team, because changing
+// the provider defaults must not move or replace them.
+func modifyPlanTeamDefaults(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, defaultTeamID, defaultVisibility string) {
+	// Defaults only apply to resources being created
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	for _, setting := range []struct {
+		attribute string
+		value     string
+	}{
+		{attribute: "team_id", value: defaultTeamID},
+		{attribute: "visibility", value: defaultVisibility},
+	} {
+		if setting.value == "" {
+			continue
+		}
+
+		var configValue types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(setting.attribute), &configValue)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if configValue.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(setting.attribute), types.StringValue(setting.value))...)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestModifyPlanTeamDefaults verifies that the provider default team and visibility are
+// planned for new resources that do not set them, and only for those.
+//
+// To run:
+//
+//	go test -v ./internal/provider/ -run TestModifyPlanTeamDefaults
+func TestModifyPlanTeamDefaults(t *testing.T) {
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"team_id":    schema.StringAttribute{Optional: true, Computed: true},
+			"visibility": schema.StringAttribute{Optional: true, Computed: true},
+		},
+	}
+	objectType := testSchema.Type().TerraformType(ctx)
+
+	object := func(teamID, visibility tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"team_id": teamID, "visibility": visibility})
+	}
+	null := tftypes.NewValue(tftypes.String, nil)
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	value := func(s string) tftypes.Value { return tftypes.NewValue(tftypes.String, s) }
+
+	tests := []struct {
+		name           string
+		config         tftypes.Value
+		state          tftypes.Value
+		wantTeamID     types.String
+		wantVisibility types.String
+	}{
+		{
+			name:           "create without team or visibility",
+			config:         object(null, null),
+			state:          tftypes.NewValue(objectType, nil),
+			wantTeamID:     types.StringValue("team-123"),
+			wantVisibility: types.StringValue("team"),
+		},
+		{
+			name:           "create with explicit team and visibility",
+			config:         object(value("team-456"), value("public")),
+			state:          tftypes.NewValue(objectType, nil),
+			wantTeamID:     types.StringUnknown(),
+			wantVisibility: types.StringUnknown(),
+		},
+		{
+			name:           "update of an existing object",
+			config:         object(null, null),
+			state:          object(value("personal-team"), value("private")),
+			wantTeamID:     types.StringUnknown(),
+			wantVisibility: types.StringUnknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: testSchema, Raw: object(unknown, unknown)}
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: testSchema, Raw: tt.config},
+				State:  tfsdk.State{Schema: testSchema, Raw: tt.state},
+				Plan:   plan,
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+
+			modifyPlanTeamDefaults(ctx, req, resp, "team-123", "team")
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics; %v", resp.Diagnostics)
+			}
+
+			var teamID, visibility types.String
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("team_id"), &teamID)...)
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("visibility"), &visibility)...)
+
+			if !teamID.Equal(tt.wantTeamID) {
+				t.Errorf("expected team_id %s, got %s", tt.wantTeamID, teamID)
+			}
+			if !visibility.Equal(tt.wantVisibility) {
+				t.Errorf("expected visibility %s, got %s", tt.wantVisibility, visibility)
+			}
+		})
+	}
+}
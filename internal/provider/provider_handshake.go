@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+// minimumGatewayVersion is the oldest ContextForge release the provider supports.
+const minimumGatewayVersion = "0.8.0"
+
+// errCredentialsRejected is returned by checkGatewayVersion when the gateway rejects the
+// provider's token.
+var errCredentialsRejected = errors.New("the gateway rejected the provider's credentials")
+
+// errUnsupportedVersion is returned by checkGatewayVersion when the gateway is older than
+// minimumGatewayVersion.
+var errUnsupportedVersion = errors.New("unsupported ContextForge version")
+
+// healthResponse is the response of the unauthenticated GET /health endpoint.
+type healthResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// versionResponse is the part of the GET /version diagnostics response the provider uses.
+type versionResponse struct {
+	App struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	} `json:"app"`
+}
+
+// checkGatewayHealth verifies that the gateway is reachable and reports itself healthy.
+// client must not carry a token, so that failures are not mistaken for credential problems.
+func checkGatewayHealth(ctx context.Context, client *contextforge.Client) error {
+	req, err := client.NewRequest(http.MethodGet, "health", nil)
+	if err != nil {
+		return fmt.Errorf("failed to build health check request; %w", err)
+	}
+
+	var health healthResponse
+	if _, err := client.Do(ctx, req, &health); err != nil {
+		return err
+	}
+
+	if health.Status != "" && !strings.EqualFold(health.Status, "healthy") {
+		if health.Error != "" {
+			return fmt.Errorf("the gateway reports status %q: %s", health.Status, health.Error)
+		}
+		return fmt.Errorf("the gateway reports status %q", health.Status)
+	}
+
+	return nil
+}
+
+// checkGatewayVersion verifies the provider's credentials against the authenticated GET
+// /version endpoint and returns the gateway's ContextForge version. The version is empty
+// when the gateway does not report one. It returns errCredentialsRejected for a rejected
+// token and errUnsupportedVersion for a gateway older than minimumGatewayVersion.
+func checkGatewayVersion(ctx context.Context, client *contextforge.Client) (string, error) {
+	req, err := client.NewRequest(http.MethodGet, "version", nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build version request; %w", err)
+	}
+
+	var version versionResponse
+	resp, err := client.Do(ctx, req, &version)
+	if err != nil {
+		if resp != nil && (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden) {
+			return "", fmt.Errorf("%w (status %d)", errCredentialsRejected, resp.StatusCode)
+		}
+		return "", err
+	}
+
+	if version.App.Version == "" {
+		return "", nil
+	}
+
+	if compareVersions(version.App.Version, minimumGatewayVersion) < 0 {
+		return version.App.Version, fmt.Errorf("%w %s; the provider requires ContextForge %s or later", errUnsupportedVersion, version.App.Version, minimumGatewayVersion)
+	}
+
+	return version.App.Version, nil
+}
+
+// parseVersion parses a ContextForge version such as "0.8.0", "v0.9.1" or "1.0.0-rc.1" into its
+// major, minor and patch numbers, ignoring any pre-release or build suffix.
+func parseVersion(version string) ([3]int, bool) {
+	var parts [3]int
+
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+
+	fields := strings.Split(version, ".")
+	if len(fields) == 0 || len(fields) > 3 {
+		return parts, false
+	}
+
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 {
+			return parts, false
+		}
+		parts[i] = n
+	}
+
+	return parts, true
+}
+
+// compareVersions returns -1, 0 or 1 when version a is older than, equal to or newer than b.
+// Versions that cannot be parsed compare as equal, so that an unexpected version string
+// never blocks the provider.
+func compareVersions(a, b string) int {
+	va, okA := parseVersion(a)
+	vb, okB := parseVersion(b)
+	if !okA || !okB {
+		return 0
+	}
+
+	for i := range va {
+		switch {
+		case va[i] < vb[i]:
+			return -1
+		case va[i] > vb[i]:
+			return 1
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+// TestCheckGatewayHealth verifies that unreachable and unhealthy gateways are detected.
+//
+// To run:
+//
+//	go test -v ./internal/provider/ -run TestCheckGatewayHealth
+func TestCheckGatewayHealth(t *testing.T) {
+	ctx := context.Background()
+
+	status := `{"status":"healthy"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(status))
+	}))
+	defer server.Close()
+
+	client, err := contextforge.NewClient(server.Client(), server.URL, "")
+	if err != nil {
+		t.Fatalf("failed to create client; %v", err)
+	}
+
+	if err := checkGatewayHealth(ctx, client); err != nil {
+		t.Errorf("expected a healthy gateway to pass, got %v", err)
+	}
+
+	status = `{"status":"unhealthy","error":"database unavailable"}`
+	if err := checkGatewayHealth(ctx, client); err == nil {
+		t.Error("expected an unhealthy gateway to be reported")
+	}
+
+	unreachable, err := contextforge.NewClient(nil, "http://127.0.0.1:1", "")
+	if err != nil {
+		t.Fatalf("failed to create client; %v", err)
+	}
+	if err := checkGatewayHealth(ctx, unreachable); err == nil {
+		t.Error("expected an unreachable gateway to be reported")
+	}
+}
+
+// TestCheckGatewayVersion verifies credential and version checks against GET /version.
+func TestCheckGatewayVersion(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantVersion string
+		wantErr     error
+	}{
+		{name: "supported", status: http.StatusOK, body: `{"app":{"name":"MCP_Gateway","version":"0.9.0"}}`, wantVersion: "0.9.0"},
+		{name: "no version", status: http.StatusOK, body: `{}`},
+		{name: "rejected token", status: http.StatusUnauthorized, body: `{"detail":"Invalid token"}`, wantErr: errCredentialsRejected},
+		{name: "forbidden", status: http.StatusForbidden, body: `{"detail":"Forbidden"}`, wantErr: errCredentialsRejected},
+		{name: "too old", status: http.StatusOK, body: `{"app":{"version":"0.7.0"}}`, wantVersion: "0.7.0", wantErr: errUnsupportedVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client, err := contextforge.NewClient(server.Client(), server.URL, "test-token")
+			if err != nil {
+				t.Fatalf("failed to create client; %v", err)
+			}
+
+			version, err := checkGatewayVersion(ctx, client)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error; %v", err)
+			}
+			if version != tt.wantVersion {
+				t.Errorf("expected version %q, got %q", tt.wantVersion, version)
+			}
+		})
+	}
+}
+
+// TestCompareVersions verifies ordering of ContextForge version strings.
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "0.8.0", b: "0.8.0", want: 0},
+		{a: "0.7.9", b: "0.8.0", want: -1},
+		{a: "0.10.0", b: "0.9.0", want: 1},
+		{a: "v1.0.0", b: "0.8.0", want: 1},
+		{a: "0.8", b: "0.8.0", want: 0},
+		{a: "1.0.0-rc.1", b: "1.0.0", want: 0},
+		{a: "unknown", b: "0.8.0", want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := compareVersions(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
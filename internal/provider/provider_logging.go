@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem is the tflog subsystem HTTP exchanges with the gateway are logged under.
+// Its level follows TF_LOG_PROVIDER unless TF_LOG_PROVIDER_CONTEXTFORGE_HTTP is set.
+const logSubsystem = "contextforge"
+
+// maxLoggedBodySize limits how much of a request or response body is logged.
+const maxLoggedBodySize = 64 * 1024
+
+// redacted replaces secret values in logged headers and bodies.
+const redacted = "***"
+
+// sensitiveLogFieldKeys are the log field keys whose values are masked by the contextforge
+// subsystem. tflog matches field keys exactly, so they are listed as spelled in log fields.
+var sensitiveLogFieldKeys = []string{
+	"password",
+	"token",
+	"access_token",
+	"refresh_token",
+	"auth_password",
+	"auth_token",
+	"auth_header_value",
+	"client_secret",
+	"api_key",
+	"secret",
+}
+
+// sensitiveLogKeys are the JSON keys whose values are never logged. Keys are compared after
+// lowercasing and removing underscores and dashes, so both the snake_case and camelCase
+// forms used by the ContextForge API match.
+var sensitiveLogKeys = func() map[string]bool {
+	keys := make(map[string]bool, len(sensitiveLogFieldKeys))
+	for _, key := range sensitiveLogFieldKeys {
+		keys[normalizeLogKey(key)] = true
+	}
+	return keys
+}()
+
+// sensitiveHeaders are HTTP headers whose values are never logged, in addition to the
+// custom headers from the provider configuration.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// bearerTokenRegex matches bearer tokens in log messages and field values, for example
+// when a token ends up in an error message.
+var bearerTokenRegex = regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9\-_.~+/]+=*`)
+
+// loggingTransport is an http.RoundTripper that logs every HTTP exchange with the gateway
+// under the contextforge tflog subsystem. The method, URL, status and duration are logged at
+// DEBUG; headers and bodies at TRACE. Secrets are redacted before logging and masked by the
+// subsystem as a second line of defense.
+type loggingTransport struct {
+	base             http.RoundTripper
+	sensitiveHeaders map[string]bool
+	maskedValues     []string
+
+	// trace reports whether the subsystem logs at TRACE, so that bodies are only read
+	// for logging when they are logged
+	trace bool
+}
+
+// newLoggingTransport returns a loggingTransport. The values of customHeaders are treated as secrets.
+func newLoggingTransport(base http.RoundTripper, customHeaders map[string]string) *loggingTransport {
+	t := &loggingTransport{
+		base:             base,
+		sensitiveHeaders: make(map[string]bool),
+		trace:            traceLoggingEnabled(),
+	}
+
+	for _, name := range sensitiveHeaders {
+		t.sensitiveHeaders[http.CanonicalHeaderKey(name)] = true
+	}
+	for name, value := range customHeaders {
+		t.sensitiveHeaders[http.CanonicalHeaderKey(name)] = true
+		if value != "" {
+			t.maskedValues = append(t.maskedValues, value)
+		}
+	}
+
+	return t
+}
+
+// logContext returns ctx with the contextforge subsystem and its masks configured.
+func (t *loggingTransport) logContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, logSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_CONTEXTFORGE", "HTTP"))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, logSubsystem, sensitiveLogFieldKeys...)
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, logSubsystem, bearerTokenRegex)
+	ctx = tflog.SubsystemMaskMessageRegexes(ctx, logSubsystem, bearerTokenRegex)
+
+	if len(t.maskedValues) > 0 {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, logSubsystem, t.maskedValues...)
+		ctx = tflog.SubsystemMaskMessageStrings(ctx, logSubsystem, t.maskedValues...)
+	}
+
+	return ctx
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := t.logContext(req.Context())
+
+	fields := map[string]any{
+		"http_method": req.Method,
+		"http_url":    req.URL.String(),
+	}
+
+	tflog.SubsystemDebug(ctx, logSubsystem, "Sending HTTP request to ContextForge", fields)
+	if t.trace {
+		tflog.SubsystemTrace(ctx, logSubsystem, "HTTP request details", map[string]any{
+			"http_method":          req.Method,
+			"http_url":             req.URL.String(),
+			"http_request_headers": t.redactHeaders(req.Header),
+			"http_request_body":    requestBodyForLog(req),
+		})
+	}
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, logSubsystem, "HTTP request to ContextForge failed", fields)
+		return resp, err
+	}
+
+	fields["http_status"] = resp.StatusCode
+	tflog.SubsystemDebug(ctx, logSubsystem, "Received HTTP response from ContextForge", fields)
+
+	if !t.trace {
+		return resp, nil
+	}
+
+	// Read at most one byte more than is logged, and hand the rest of the body to the caller unread
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxLoggedBodySize+1))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+
+	tflog.SubsystemTrace(ctx, logSubsystem, "HTTP response details", map[string]any{
+		"http_method":           req.Method,
+		"http_url":              req.URL.String(),
+		"http_status":           resp.StatusCode,
+		"http_response_headers": t.redactHeaders(resp.Header),
+		"http_response_body":    bodyForLog(body),
+	})
+
+	return resp, nil
+}
+
+// traceLoggingEnabled reports whether the contextforge subsystem logs at TRACE. It follows
+// the environment variables tflog takes the subsystem level from: the subsystem's own
+// variable, then the provider's, then TF_LOG.
+func traceLoggingEnabled() bool {
+	for _, name := range []string{"TF_LOG_PROVIDER_CONTEXTFORGE_HTTP", "TF_LOG_PROVIDER", "TF_LOG"} {
+		if level := strings.TrimSpace(os.Getenv(name)); level != "" {
+			return strings.EqualFold(level, "TRACE") || strings.EqualFold(level, "JSON")
+		}
+	}
+	return false
+}
+
+// redactHeaders returns the headers as a map suitable for logging, with secret values replaced.
+func (t *loggingTransport) redactHeaders(header http.Header) map[string]string {
+	out := make(map[string]string, len(header))
+	for name, values := range header {
+		if t.sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			out[name] = redacted
+			continue
+		}
+		out[name] = strings.Join(values, ", ")
+	}
+	return out
+}
+
+// requestBodyForLog returns the request body for logging without consuming it.
+func requestBodyForLog(req *http.Request) string {
+	if req.Body == nil || req.Body == http.NoBody {
+		return ""
+	}
+	if req.GetBody == nil {
+		return "(body not replayable, not logged)"
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, maxLoggedBodySize+1))
+	if err != nil {
+		return ""
+	}
+
+	return bodyForLog(data)
+}
+
+// bodyForLog returns a body for logging, with secret JSON values redacted and long bodies truncated.
+func bodyForLog(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	truncated := len(body) > maxLoggedBodySize
+	if truncated {
+		body = body[:maxLoggedBodySize]
+	}
+
+	var decoded any
+	if !truncated && json.Unmarshal(body, &decoded) == nil {
+		if redactedBody, err := json.Marshal(redactJSON(decoded, "")); err == nil {
+			return string(redactedBody)
+		}
+	}
+
+	// Bodies that cannot be parsed could still contain secrets, so only their size is logged
+	if truncated {
+		return "(body larger than 64 KiB, not logged)"
+	}
+	return "(non-JSON body, not logged)"
+}
+
+// redactJSON replaces the values of sensitive keys in a decoded JSON value. parentKey is
+// the key the value is stored under, so that the values of gateway auth_headers entries
+// ({"key": ..., "value": ...}) are redacted as well.
+func redactJSON(v any, parentKey string) any {
+	switch value := v.(type) {
+	case map[string]any:
+		for key, child := range value {
+			normalized := normalizeLogKey(key)
+			if sensitiveLogKeys[normalized] || (normalized == "value" && parentKey == "authheaders") {
+				if child != nil {
+					value[key] = redacted
+				}
+				continue
+			}
+			value[key] = redactJSON(child, normalized)
+		}
+		return value
+	case []any:
+		for i, child := range value {
+			value[i] = redactJSON(child, parentKey)
+		}
+		return value
+	default:
+		return v
+	}
+}
+
+// normalizeLogKey lowercases key and removes underscores and dashes.
+func normalizeLogKey(key string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// defaultConfigFile is the location of the connection profiles file, relative to the user's
+// home directory, used when CONTEXTFORGE_CONFIG_FILE is not set.
+const defaultConfigFile = ".contextforge/config"
+
+// configFile is the JSON document holding named connection profiles, e.g.:
+//
+//	{
+//	  "profiles": {
+//	    "dev":  {"address": "http://localhost:8000", "token_file": "~/.contextforge/dev-token"},
+//	    "prod": {"address": "https://contextforge.example.com", "email": "admin@example.com", "password": "..."}
+//	  }
+//	}
+type configFile struct {
+	Profiles map[string]connectionProfile `json:"profiles"`
+}
+
+// connectionProfile holds the connection settings of a single profile. Its fields mirror the
+// provider attributes of the same name.
+type connectionProfile struct {
+	Address      string      `json:"address"`
+	BasePath     string      `json:"base_path"`
+	Token        string      `json:"token"`
+	TokenFile    string      `json:"token_file"`
+	TokenCommand []string    `json:"token_command"`
+	Email        string      `json:"email"`
+	Password     string      `json:"password"`
+	TLS          *profileTLS `json:"tls"`
+}
+
+// profileTLS holds the TLS settings of a connection profile.
+type profileTLS struct {
+	CACertFile         string `json:"ca_cert_file"`
+	CACertPEM          string `json:"ca_cert_pem"`
+	ClientCert         string `json:"client_cert"`
+	ClientKey          string `json:"client_key"`
+	InsecureSkipVerify bool   `json:"insecure_skip_verify"`
+}
+
+// configFilePath returns the path of the connection profiles file: CONTEXTFORGE_CONFIG_FILE
+// when set, otherwise ~/.contextforge/config.
+func configFilePath() (string, error) {
+	if path := os.Getenv("CONTEXTFORGE_CONFIG_FILE"); path != "" {
+		return expandHome(path)
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to determine the home directory; set CONTEXTFORGE_CONFIG_FILE instead; %w", err)
+	}
+
+	return filepath.Join(home, defaultConfigFile), nil
+}
+
+// loadProfile reads the profile called name from the connection profiles file at path.
+// Paths in the profile starting with "~/" are expanded to the user's home directory.
+func loadProfile(path, name string) (*connectionProfile, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("profile %q was selected but the config file %s does not exist", name, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read config file %s; %w", path, err)
+	}
+
+	// Unknown fields are rejected so that a misspelled setting is not silently ignored
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var config configFile
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("unable to parse config file %s; %w", path, err)
+	}
+
+	profile, ok := config.Profiles[name]
+	if !ok {
+		names := make([]string, 0, len(config.Profiles))
+		for n := range config.Profiles {
+			names = append(names, n)
+		}
+		slices.Sort(names)
+		return nil, fmt.Errorf("profile %q not found in config file %s; available profiles: %s", name, path, strings.Join(names, ", "))
+	}
+
+	paths := []*string{&profile.TokenFile}
+	if profile.TLS != nil {
+		paths = append(paths, &profile.TLS.CACertFile, &profile.TLS.ClientCert, &profile.TLS.ClientKey)
+	}
+	for _, p := range paths {
+		if *p, err = expandHome(*p); err != nil {
+			return nil, err
+		}
+	}
+
+	return &profile, nil
+}
+
+// credentials returns the credential settings of the profile.
+func (p *connectionProfile) credentials() providerCredentials {
+	return providerCredentials{
+		Token:        p.Token,
+		TokenFile:    p.TokenFile,
+		TokenCommand: p.TokenCommand,
+		Email:        p.Email,
+		Password:     p.Password,
+	}
+}
+
+// withProfile returns s overridden by the TLS settings of a connection profile. As with the
+// tls configuration block, a CA or client certificate replaces the previous values as a pair.
+func (s tlsSettings) withProfile(p *connectionProfile) tlsSettings {
+	if p == nil || p.TLS == nil {
+		return s
+	}
+
+	if p.TLS.CACertFile != "" || p.TLS.CACertPEM != "" {
+		s.CACertFile = p.TLS.CACertFile
+		s.CACertPEM = p.TLS.CACertPEM
+	}
+
+	if p.TLS.ClientCert != "" || p.TLS.ClientKey != "" {
+		s.ClientCert = p.TLS.ClientCert
+		s.ClientKey = p.TLS.ClientKey
+	}
+
+	if p.TLS.InsecureSkipVerify {
+		s.InsecureSkipVerify = true
+	}
+
+	return s
+}
+
+// expandHome replaces a leading "~/" in path with the user's home directory.
+func expandHome(path string) (string, error) {
+	if !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to expand %s; %w", path, err)
+	}
+
+	return filepath.Join(home, path[2:]), nil
+}
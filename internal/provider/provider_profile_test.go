@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestLoadProfile verifies reading connection profiles from the ContextForge config file.
+//
+// To run:
+//
+//	go test -v ./internal/provider/ -run TestLoadProfile
+func TestLoadProfile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	configPath := filepath.Join(home, ".contextforge", "config")
+	if err := os.MkdirAll(filepath.Dir(configPath), 0o700); err != nil {
+		t.Fatalf("failed to create config directory; %v", err)
+	}
+
+	config := `{
+  "profiles": {
+    "dev": {"address": "http://localhost:8000", "token_file": "~/.contextforge/dev-token"},
+    "prod": {
+      "address": "https://contextforge.example.com",
+      "base_path": "/mcp-gateway",
+      "token_command": ["vault", "read", "-field=token", "secret/contextforge"],
+      "tls": {"ca_cert_file": "~/ca.pem"}
+    }
+  }
+}`
+	if err := os.WriteFile(configPath, []byte(config), 0o600); err != nil {
+		t.Fatalf("failed to write config file; %v", err)
+	}
+
+	path, err := configFilePath()
+	if err != nil || path != configPath {
+		t.Fatalf("expected default config path %s, got %s (%v)", configPath, path, err)
+	}
+
+	dev, err := loadProfile(path, "dev")
+	if err != nil {
+		t.Fatalf("failed to load profile; %v", err)
+	}
+	if dev.Address != "http://localhost:8000" || dev.TokenFile != filepath.Join(home, ".contextforge", "dev-token") {
+		t.Errorf("unexpected dev profile %+v", dev)
+	}
+	if methods := dev.credentials().methods(); !slices.Equal(methods, []string{"token_file"}) {
+		t.Errorf("expected token_file credentials, got %v", methods)
+	}
+
+	prod, err := loadProfile(path, "prod")
+	if err != nil {
+		t.Fatalf("failed to load profile; %v", err)
+	}
+	envTLS, err := tlsSettingsFromEnv()
+	if err != nil {
+		t.Fatalf("failed to read TLS settings; %v", err)
+	}
+	tlsCfg := envTLS.withProfile(prod).withConfig(nil)
+	if tlsCfg.CACertFile != filepath.Join(home, "ca.pem") {
+		t.Errorf("expected the profile CA bundle to be used, got %+v", tlsCfg)
+	}
+	if !slices.Equal(prod.TokenCommand, []string{"vault", "read", "-field=token", "secret/contextforge"}) {
+		t.Errorf("unexpected token command %v", prod.TokenCommand)
+	}
+
+	if _, err := loadProfile(path, "stage"); err == nil || !strings.Contains(err.Error(), "available profiles: dev, prod") {
+		t.Errorf("expected an error listing the available profiles, got %v", err)
+	}
+
+	// CONTEXTFORGE_CONFIG_FILE replaces the default location
+	other := filepath.Join(t.TempDir(), "contextforge.json")
+	if err := os.WriteFile(other, []byte(`{"profiles": {"dev": {"adress": "http://localhost:8000"}}}`), 0o600); err != nil {
+		t.Fatalf("failed to write config file; %v", err)
+	}
+	t.Setenv("CONTEXTFORGE_CONFIG_FILE", other)
+
+	if path, _ := configFilePath(); path != other {
+		t.Errorf("expected CONTEXTFORGE_CONFIG_FILE to be used, got %s", path)
+	}
+	if _, err := loadProfile(other, "dev"); err == nil || !strings.Contains(err.Error(), "adress") {
+		t.Errorf("expected a misspelled setting to be rejected, got %v", err)
+	}
+	if _, err := loadProfile(filepath.Join(home, "missing"), "dev"); err == nil || !strings.Contains(err.Error(), "does not exist") {
+		t.Errorf("expected a missing config file to be reported, got %v", err)
+	}
+}
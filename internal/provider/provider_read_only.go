@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// checkReadOnly reports whether the provider is in read-only mode and, if so, adds an error
+// diagnostic for the blocked operation. Resources call it at the start of Create, Update and
+// Delete, before any request is sent to the gateway.
+func checkReadOnly(readOnly bool, operation, object string, diags *diag.Diagnostics) bool {
+	if !readOnly {
+		return false
+	}
+
+	diags.AddError(
+		"Provider Is Read-Only",
+		fmt.Sprintf("Unable to %s %s; the provider is in read-only mode (read_only = true or CONTEXTFORGE_READ_ONLY) "+
+			"and does not change anything on the gateway. Disable read-only mode to apply this plan.", operation, object),
+	)
+
+	return true
+}
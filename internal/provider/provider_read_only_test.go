@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestCheckReadOnly verifies that read-only mode blocks changes with a clear diagnostic.
+//
+// To run:
+//
+//	go test -v ./internal/provider/ -run TestCheckReadOnly
+func TestCheckReadOnly(t *testing.T) {
+	var diags diag.Diagnostics
+	if checkReadOnly(false, "create", "gateway", &diags) || diags.HasError() {
+		t.Fatalf("expected changes to be allowed outside read-only mode, got %v", diags)
+	}
+
+	if !checkReadOnly(true, "delete", "gateway", &diags) {
+		t.Fatal("expected changes to be blocked in read-only mode")
+	}
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail(), "Unable to delete gateway") {
+		t.Errorf("expected a diagnostic naming the blocked operation, got %v", diags)
+	}
+}
+
+// TestBoolSetting verifies that boolean settings fall back to their environment variable.
+func TestBoolSetting(t *testing.T) {
+	t.Setenv("CONTEXTFORGE_READ_ONLY", "true")
+
+	if v, err := boolSetting(types.BoolNull(), "CONTEXTFORGE_READ_ONLY", false); err != nil || !v {
+		t.Errorf("expected the environment variable to enable the setting, got %t (%v)", v, err)
+	}
+
+	if v, err := boolSetting(types.BoolValue(false), "CONTEXTFORGE_READ_ONLY", false); err != nil || v {
+		t.Errorf("expected the configuration value to take precedence, got %t (%v)", v, err)
+	}
+
+	t.Setenv("CONTEXTFORGE_READ_ONLY", "yes")
+	if _, err := boolSetting(types.BoolNull(), "CONTEXTFORGE_READ_ONLY", false); err == nil {
+		t.Error("expected an invalid environment value to be rejected")
+	}
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+// tagsAllSchemaAttribute returns the computed tags_all attribute shared by all taggable resources.
+func tagsAllSchemaAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		MarkdownDescription: "All tags of the object, including those inherited from the provider's `default_tags`",
+		Description:         "All tags of the object, including those inherited from the provider's default_tags",
+		ElementType:         types.StringType,
+		Computed:            true,
+	}
+}
+
+// mergeTags returns tags followed by the default tags not already present, without duplicates.
+func mergeTags(tags, defaultTags []string) []string {
+	merged := make([]string, 0, len(tags)+len(defaultTags))
+	seen := make(map[string]bool, len(tags)+len(defaultTags))
+
+	for _, list := range [][]string{tags, defaultTags} {
+		for _, tag := range list {
+			if !seen[tag] {
+				seen[tag] = true
+				merged = append(merged, tag)
+			}
+		}
+	}
+
+	return merged
+}
+
+// requestTags returns the tags to send to the API for a planned tags value: the planned
+// tags merged with the provider's default tags. The boolean result is false when there is
+// nothing to send, leaving the object's tags unchanged.
+func requestTags(ctx context.Context, planTags types.List, defaultTags []string) ([]string, bool, diag.Diagnostics) {
+	var tags []string
+	if !planTags.IsNull() && !planTags.IsUnknown() {
+		diags := planTags.ElementsAs(ctx, &tags, false)
+		if diags.HasError() {
+			return nil, false, diags
+		}
+	} else if len(defaultTags) == 0 {
+		return nil, false, nil
+	}
+
+	return mergeTags(tags, defaultTags), true, nil
+}
+
+// clearTags removes all tags from the object at urlStr. The SDK's update types leave out
+// an empty tag list, so it is sent in a request of its own before the update.
+func clearTags(ctx context.Context, client *contextforge.Client, urlStr string) error {
+	req, err := client.NewRequest(http.MethodPut, urlStr, map[string][]string{"tags": {}})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
+
+// stateTags splits the tags reported by the API into the values of the tags and tags_all
+// attributes. Default tags are left out of tags unless they were also set on the resource
+// itself (priorTags), so that tags inherited from the provider never cause a diff.
+func stateTags(ctx context.Context, apiTags []contextforge.Tag, priorTags types.List, defaultTags []string) (types.List, types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	all := contextforge.TagNames(apiTags)
+	if all == nil {
+		all = []string{}
+	}
+
+	tagsAll, d := types.ListValueFrom(ctx, types.StringType, all)
+	diags.Append(d...)
+
+	if apiTags == nil {
+		return types.ListNull(types.StringType), tagsAll, diags
+	}
+
+	configured := make(map[string]bool)
+	if !priorTags.IsNull() && !priorTags.IsUnknown() {
+		var prior []string
+		diags.Append(priorTags.ElementsAs(ctx, &prior, false)...)
+		for _, tag := range prior {
+			configured[tag] = true
+		}
+	}
+
+	inherited := make(map[string]bool, len(defaultTags))
+	for _, tag := range defaultTags {
+		inherited[tag] = !configured[tag]
+	}
+
+	own := make([]string, 0, len(all))
+	for _, tag := range all {
+		if !inherited[tag] {
+			own = append(own, tag)
+		}
+	}
+
+	tags, d := types.ListValueFrom(ctx, types.StringType, own)
+	diags.Append(d...)
+
+	return tags, tagsAll, diags
+}
+
+// modifyPlanTagsAll sets tags_all in a resource plan to the configured tags merged with the
+// provider's default tags, so the plan shows the effective tags of the object.
+func modifyPlanTagsAll(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, defaultTags []string) {
+	// Nothing to plan when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configTags types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tags"), &configTags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without tags from either source the object's tags are left alone, so tags_all is
+	// planned by the framework as for any other computed attribute, unless default tags
+	// that were removed from the provider are still on the object
+	if configTags.IsNull() && len(defaultTags) == 0 {
+		modifyPlanRemovedDefaultTags(ctx, req, resp)
+		return
+	}
+
+	if configTags.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.ListUnknown(types.StringType))...)
+		return
+	}
+
+	var tags []string
+	if !configTags.IsNull() {
+		resp.Diagnostics.Append(configTags.ElementsAs(ctx, &tags, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tagsAll, diags := types.ListValueFrom(ctx, types.StringType, mergeTags(tags, defaultTags))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
+// modifyPlanRemovedDefaultTags plans the removal of inherited default tags from an object
+// whose tags are not configured after all default tags were removed from the provider. The
+// object's own tags are planned as its tags, so that Update sends them without the stale
+// default tags, and tags_all is planned to match.
+func modifyPlanRemovedDefaultTags(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var stateTags, stateTagsAll types.List
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tags"), &stateTags)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tags_all"), &stateTagsAll)...)
+	if resp.Diagnostics.HasError() || stateTagsAll.IsNull() || stateTagsAll.IsUnknown() || stateTags.IsUnknown() {
+		return
+	}
+
+	var own, all []string
+	if !stateTags.IsNull() {
+		resp.Diagnostics.Append(stateTags.ElementsAs(ctx, &own, false)...)
+	}
+	resp.Diagnostics.Append(stateTagsAll.ElementsAs(ctx, &all, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stale := false
+	for _, tag := range all {
+		if !slices.Contains(own, tag) {
+			stale = true
+			break
+		}
+	}
+	if !stale {
+		return
+	}
+
+	if own == nil {
+		own = []string{}
+	}
+	tags, diags := types.ListValueFrom(ctx, types.StringType, own)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags"), tags)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tags)...)
+}
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+// TestMergeTags verifies that default tags are appended to resource tags without duplicates.
+//
+// To run:
+//
+//	go test -v ./internal/provider/ -run TestMergeTags
+func TestMergeTags(t *testing.T) {
+	tests := []struct {
+		name        string
+		tags        []string
+		defaultTags []string
+		want        []string
+	}{
+		{name: "no tags", want: []string{}},
+		{name: "defaults only", defaultTags: []string{"team:platform", "env:prod"}, want: []string{"team:platform", "env:prod"}},
+		{name: "resource tags only", tags: []string{"api"}, want: []string{"api"}},
+		{name: "merged", tags: []string{"api"}, defaultTags: []string{"team:platform"}, want: []string{"api", "team:platform"}},
+		{name: "overlapping", tags: []string{"team:platform", "api"}, defaultTags: []string{"team:platform", "env:prod"}, want: []string{"team:platform", "api", "env:prod"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mergeTags(tt.tags, tt.defaultTags); !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestRequestTags verifies which tags are sent to the API for planned tags values.
+func TestRequestTags(t *testing.T) {
+	ctx := context.Background()
+
+	tags, ok, diags := requestTags(ctx, types.ListNull(types.StringType), nil)
+	if diags.HasError() || ok || tags != nil {
+		t.Errorf("expected no tags to be sent without tags or defaults, got %v (%t)", tags, ok)
+	}
+
+	tags, ok, _ = requestTags(ctx, types.ListUnknown(types.StringType), []string{"env:prod"})
+	if !ok || !slices.Equal(tags, []string{"env:prod"}) {
+		t.Errorf("expected the default tags to be sent, got %v (%t)", tags, ok)
+	}
+
+	planned, _ := types.ListValueFrom(ctx, types.StringType, []string{"api", "env:prod"})
+	tags, ok, _ = requestTags(ctx, planned, []string{"env:prod", "team:platform"})
+	if !ok || !slices.Equal(tags, []string{"api", "env:prod", "team:platform"}) {
+		t.Errorf("expected merged tags to be sent, got %v (%t)", tags, ok)
+	}
+}
+
+// TestStateTags verifies that tags inherited from default_tags only appear in tags_all.
+func TestStateTags(t *testing.T) {
+	ctx := context.Background()
+	defaultTags := []string{"team:platform", "env:prod"}
+	apiTags := contextforge.NewTags([]string{"api", "team:platform", "env:prod"})
+
+	elements := func(list types.List) []string {
+		var out []string
+		if diags := list.ElementsAs(ctx, &out, false); diags.HasError() {
+			t.Fatalf("failed to read list; %v", diags)
+		}
+		return out
+	}
+
+	prior, _ := types.ListValueFrom(ctx, types.StringType, []string{"api", "env:prod"})
+	tags, tagsAll, diags := stateTags(ctx, apiTags, prior, defaultTags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics; %v", diags)
+	}
+	if got := elements(tags); !slices.Equal(got, []string{"api", "env:prod"}) {
+		t.Errorf("expected tags to keep configured tags only, got %v", got)
+	}
+	if got := elements(tagsAll); !slices.Equal(got, []string{"api", "team:platform", "env:prod"}) {
+		t.Errorf("expected tags_all to contain all tags, got %v", got)
+	}
+
+	// On import or when tags are not configured, every default tag is treated as inherited
+	tags, _, _ = stateTags(ctx, apiTags, types.ListUnknown(types.StringType), defaultTags)
+	if got := elements(tags); !slices.Equal(got, []string{"api"}) {
+		t.Errorf("expected default tags to be left out of tags, got %v", got)
+	}
+
+	// Without default tags the API tags are used as is
+	tags, _, _ = stateTags(ctx, apiTags, types.ListNull(types.StringType), nil)
+	if got := elements(tags); !slices.Equal(got, []string{"api", "team:platform", "env:prod"}) {
+		t.Errorf("expected all tags without defaults, got %v", got)
+	}
+
+	tags, tagsAll, _ = stateTags(ctx, nil, types.ListNull(types.StringType), defaultTags)
+	if !tags.IsNull() || tagsAll.IsNull() || len(tagsAll.Elements()) != 0 {
+		t.Errorf("expected null tags and empty tags_all without API tags, got %v and %v", tags, tagsAll)
+	}
+}
+
+// TestModifyPlanTagsAll verifies the planned tags and tags_all, including the removal of
+// inherited tags after all default tags were removed from the provider.
+//
+// To run:
+//
+//	go test -v ./internal/provider/ -run TestModifyPlanTagsAll
+func TestModifyPlanTagsAll(t *testing.T) {
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"tags_all": tagsAllSchemaAttribute(),
+		},
+	}
+	objectType := testSchema.Type().TerraformType(ctx)
+	listType := tftypes.List{ElementType: tftypes.String}
+
+	list := func(tags ...string) tftypes.Value {
+		values := make([]tftypes.Value, len(tags))
+		for i, tag := range tags {
+			values[i] = tftypes.NewValue(tftypes.String, tag)
+		}
+		return tftypes.NewValue(listType, values)
+	}
+	object := func(tags, tagsAll tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"tags": tags, "tags_all": tagsAll})
+	}
+	null := tftypes.NewValue(listType, nil)
+
+	tests := []struct {
+		name        string
+		config      tftypes.Value
+		state       tftypes.Value
+		plan        tftypes.Value
+		defaultTags []string
+		wantTags    []string
+		wantTagsAll []string
+	}{
+		{
+			name:        "configured tags merged with default tags",
+			config:      object(list("api"), null),
+			state:       tftypes.NewValue(objectType, nil),
+			plan:        object(list("api"), tftypes.NewValue(listType, tftypes.UnknownValue)),
+			defaultTags: []string{"env:prod"},
+			wantTags:    []string{"api"},
+			wantTagsAll: []string{"api", "env:prod"},
+		},
+		{
+			name:        "default tags removed from unconfigured tags",
+			config:      object(null, null),
+			state:       object(null, list("env:prod")),
+			plan:        object(null, list("env:prod")),
+			wantTags:    []string{},
+			wantTagsAll: []string{},
+		},
+		{
+			name:        "default tags removed keeping the object's own tags",
+			config:      object(null, null),
+			state:       object(list("api"), list("api", "env:prod")),
+			plan:        object(list("api"), list("api", "env:prod")),
+			wantTags:    []string{"api"},
+			wantTagsAll: []string{"api"},
+		},
+		{
+			name:        "no tags from either source",
+			config:      object(null, null),
+			state:       object(list("api"), list("api")),
+			plan:        object(list("api"), list("api")),
+			wantTags:    []string{"api"},
+			wantTagsAll: []string{"api"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: testSchema, Raw: tt.plan}
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: testSchema, Raw: tt.config},
+				State:  tfsdk.State{Schema: testSchema, Raw: tt.state},
+				Plan:   plan,
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+
+			modifyPlanTagsAll(ctx, req, resp, tt.defaultTags)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics; %v", resp.Diagnostics)
+			}
+
+			var tags, tagsAll []string
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("tags_all"), &tagsAll)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("failed to read plan; %v", resp.Diagnostics)
+			}
+
+			if !slices.Equal(tags, tt.wantTags) || (tags == nil) != (tt.wantTags == nil) {
+				t.Errorf("expected tags %v, got %v", tt.wantTags, tags)
+			}
+			if !slices.Equal(tagsAll, tt.wantTagsAll) {
+				t.Errorf("expected tags_all %v, got %v", tt.wantTagsAll, tagsAll)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// providerTLSModel describes the provider's tls configuration block.
+type providerTLSModel struct {
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+}
+
+// tlsSettings holds the resolved TLS settings after environment defaults and
+// configuration values have been merged.
+type tlsSettings struct {
+	CACertFile         string
+	CACertPEM          string
+	ClientCert         string
+	ClientKey          string
+	InsecureSkipVerify bool
+}
+
+// tlsSettingsFromEnv reads the TLS settings from environment variables.
+func tlsSettingsFromEnv() (tlsSettings, error) {
+	insecure, err := boolSetting(types.BoolNull(), "CONTEXTFORGE_TLS_INSECURE_SKIP_VERIFY", false)
+	if err != nil {
+		return tlsSettings{}, err
+	}
+
+	return tlsSettings{
+		CACertFile:         os.Getenv("CONTEXTFORGE_CA_CERT_FILE"),
+		ClientCert:         os.Getenv("CONTEXTFORGE_CLIENT_CERT"),
+		ClientKey:          os.Getenv("CONTEXTFORGE_CLIENT_KEY"),
+		InsecureSkipVerify: insecure,
+	}, nil
+}
+
+// withConfig returns s overridden by the values set in the tls configuration block.
+// A CA or client certificate set in the block replaces the corresponding environment values as a pair.
+func (s tlsSettings) withConfig(m *providerTLSModel) tlsSettings {
+	if m == nil {
+		return s
+	}
+
+	if !m.CACertFile.IsNull() || !m.CACertPEM.IsNull() {
+		s.CACertFile = m.CACertFile.ValueString()
+		s.CACertPEM = m.CACertPEM.ValueString()
+	}
+
+	if !m.ClientCert.IsNull() || !m.ClientKey.IsNull() {
+		s.ClientCert = m.ClientCert.ValueString()
+		s.ClientKey = m.ClientKey.ValueString()
+	}
+
+	if !m.InsecureSkipVerify.IsNull() {
+		s.InsecureSkipVerify = m.InsecureSkipVerify.ValueBool()
+	}
+
+	return s
+}
+
+// isZero reports whether s leaves Go's default TLS behaviour unchanged.
+func (s tlsSettings) isZero() bool {
+	return s == tlsSettings{}
+}
+
+// buildTLSConfig returns a tls.Config for the given settings.
+//
+// A custom CA is added to the system certificate pool rather than replacing it,
+// so gateways with publicly trusted certificates keep working.
+func buildTLSConfig(s tlsSettings) (*tls.Config, error) {
+	if s.CACertFile != "" && s.CACertPEM != "" {
+		return nil, errors.New("only one of ca_cert_file and ca_cert_pem may be set")
+	}
+	if (s.ClientCert == "") != (s.ClientKey == "") {
+		return nil, errors.New("client_cert and client_key must be set together")
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: s.InsecureSkipVerify,
+	}
+
+	caPEM := []byte(s.CACertPEM)
+	if s.CACertFile != "" {
+		data, err := os.ReadFile(s.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ca_cert_file; %w", err)
+		}
+		caPEM = data
+	}
+
+	if len(caPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no valid PEM certificates found in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if s.ClientCert != "" {
+		certPEM, err := pemOrFile(s.ClientCert)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client_cert; %w", err)
+		}
+		keyPEM, err := pemOrFile(s.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client_key; %w", err)
+		}
+
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse client certificate and key; %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// pemOrFile returns value itself when it holds PEM-encoded data, and otherwise
+// treats it as a path and returns the contents of that file.
+func pemOrFile(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	data, err := os.ReadFile(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
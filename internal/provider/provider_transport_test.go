@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestRetryTransport verifies which failures the retry transport retries for
+// idempotent and non-idempotent requests.
+//
+// To run:
+//
+//	go test -v ./internal/provider/ -run TestRetryTransport
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int
+		wantStatus   int
+		wantAttempts int32
+	}{
+		{name: "get retried after 503", method: http.MethodGet, statuses: []int{503, 502, 200}, wantStatus: 200, wantAttempts: 3},
+		{name: "get gives up after max retries", method: http.MethodGet, statuses: []int{504, 504, 504, 504, 504}, wantStatus: 504, wantAttempts: 3},
+		{name: "get not retried after 500", method: http.MethodGet, statuses: []int{500, 200}, wantStatus: 500, wantAttempts: 1},
+		{name: "post retried after 429", method: http.MethodPost, statuses: []int{429, 200}, wantStatus: 200, wantAttempts: 2},
+		{name: "post not retried after 502", method: http.MethodPost, statuses: []int{502, 200}, wantStatus: 502, wantAttempts: 1},
+		{name: "delete retried after 502", method: http.MethodDelete, statuses: []int{502, 204}, wantStatus: 204, wantAttempts: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+				body, _ := io.ReadAll(r.Body)
+				if r.Method == http.MethodPost && string(body) != `{"name":"replayed"}` {
+					t.Errorf("attempt %d: expected request body to be replayed, got %q", n, body)
+				}
+				w.WriteHeader(tt.statuses[n-1])
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 2, time.Millisecond, 5*time.Millisecond)}
+
+			var body io.Reader
+			if tt.method == http.MethodPost {
+				body = strings.NewReader(`{"name":"replayed"}`)
+			}
+			req, err := http.NewRequest(tt.method, server.URL, body)
+			if err != nil {
+				t.Fatalf("failed to build request; %v", err)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("request failed; %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+			if got := attempts.Load(); got != tt.wantAttempts {
+				t.Errorf("expected %d attempts, got %d", tt.wantAttempts, got)
+			}
+		})
+	}
+}
+
+// TestRetryTransport_retryAfter verifies that the Retry-After header overrides the backoff.
+func TestRetryTransport_retryAfter(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRetryTransport(http.DefaultTransport, 1, time.Millisecond, time.Minute)}
+
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("request failed; %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected the retry to wait for Retry-After, waited %s", elapsed)
+	}
+}
+
+// TestRetryTransport_retryAfterCapped verifies that a Retry-After longer than the maximum
+// wait is capped at the maximum wait.
+func TestRetryTransport_retryAfterCapped(t *testing.T) {
+	transport := newRetryTransport(http.DefaultTransport, 1, time.Millisecond, 2*time.Second)
+
+	for _, value := range []string{"3600", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)} {
+		resp := &http.Response{Header: http.Header{"Retry-After": []string{value}}}
+		if wait := transport.backoff(0, resp); wait != 2*time.Second {
+			t.Errorf("expected Retry-After %q to be capped at 2s, got %s", value, wait)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"1"}}}
+	if wait := transport.backoff(0, resp); wait != time.Second {
+		t.Errorf("expected a shorter Retry-After to be honoured, got %s", wait)
+	}
+}
+
+// TestRetryTransport_connectionRefused verifies that requests are retried when the
+// gateway is not accepting connections.
+func TestRetryTransport_connectionRefused(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to reserve a port; %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	var attempts int
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	client := &http.Client{Transport: newRetryTransport(base, 2, time.Millisecond, time.Millisecond)}
+
+	if _, err := client.Post("http://"+addr, "application/json", strings.NewReader(`{}`)); err == nil {
+		t.Fatal("expected request to fail")
+	} else if !isDialError(err) {
+		t.Errorf("expected a dial error, got %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+// roundTripperFunc adapts a function to the http.RoundTripper interface.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+// RoundTrip implements http.RoundTripper.
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// TestRetryAfter verifies parsing of the Retry-After header.
+func TestRetryAfter(t *testing.T) {
+	if wait, ok := retryAfter("5"); !ok || wait != 5*time.Second {
+		t.Errorf("expected 5s, got %s (ok=%t)", wait, ok)
+	}
+
+	date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	if wait, ok := retryAfter(date); !ok || wait <= 0 || wait > 10*time.Second {
+		t.Errorf("expected a wait of up to 10s, got %s (ok=%t)", wait, ok)
+	}
+
+	if _, ok := retryAfter("soon"); ok {
+		t.Error("expected an invalid value to be ignored")
+	}
+}
+
+// TestTimeoutTransport verifies that the request timeout applies to each attempt, so a
+// hung attempt is abandoned and retried rather than stalling the request indefinitely.
+func TestTimeoutTransport(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	transport := newRetryTransport(newTimeoutTransport(http.DefaultTransport, 100*time.Millisecond), 1, time.Millisecond, time.Millisecond)
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("request failed; %v", err)
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatalf("failed to read response body; %v", err)
+	}
+
+	if string(body) != "ok" {
+		t.Errorf("expected body %q, got %q", "ok", body)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+
+	noRetry := &http.Client{Transport: newTimeoutTransport(http.DefaultTransport, 100*time.Millisecond)}
+	attempts.Store(0)
+	if _, err := noRetry.Get(server.URL); err == nil || !strings.Contains(err.Error(), "timed out after") {
+		t.Errorf("expected a request timeout error, got %v", err)
+	}
+}
+
+// TestLimitTransport verifies that the limit transport caps concurrent requests and
+// spaces requests according to the configured rate.
+//
+// To run:
+//
+//	go test -v ./internal/provider/ -run TestLimitTransport
+func TestLimitTransport(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	run := func(t *testing.T, client *http.Client, requests int) {
+		t.Helper()
+
+		errs := make(chan error, requests)
+		for i := 0; i < requests; i++ {
+			go func() {
+				resp, err := client.Get(server.URL)
+				if err == nil {
+					resp.Body.Close()
+				}
+				errs <- err
+			}()
+		}
+		for i := 0; i < requests; i++ {
+			if err := <-errs; err != nil {
+				t.Errorf("request failed; %v", err)
+			}
+		}
+	}
+
+	t.Run("max concurrent requests", func(t *testing.T) {
+		peak.Store(0)
+		run(t, &http.Client{Transport: newLimitTransport(http.DefaultTransport, 0, 2)}, 8)
+
+		if got := peak.Load(); got > 2 {
+			t.Errorf("expected at most 2 requests in flight, got %d", got)
+		}
+	})
+
+	t.Run("requests per second", func(t *testing.T) {
+		start := time.Now()
+		run(t, &http.Client{Transport: newLimitTransport(http.DefaultTransport, 20, 0)}, 25)
+
+		// A burst of 20 is allowed immediately, the remaining 5 requests take at least 250ms
+		if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+			t.Errorf("expected requests to be rate limited, all completed in %s", elapsed)
+		}
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		if _, ok := newLimitTransport(http.DefaultTransport, 0, 0).(*limitTransport); ok {
+			t.Error("expected no limit transport when both limits are disabled")
+		}
+	})
+}
+
+// TestProxyAndHeaders verifies that requests are sent through the configured proxy, with
+// proxy credentials taken from proxy_url, and carry the custom headers.
+//
+// To run:
+//
+//	go test -v ./internal/provider/ -run TestProxyAndHeaders
+func TestProxyAndHeaders(t *testing.T) {
+	var proxied atomic.Bool
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Host != "contextforge.invalid" {
+			t.Errorf("expected proxied request for contextforge.invalid, got %q", r.URL.Host)
+		}
+		if got := r.Header.Get("Proxy-Authorization"); got != "Basic dXNlcjpzZWNyZXQ=" {
+			t.Errorf("expected proxy credentials, got %q", got)
+		}
+		if got := r.Header.Get("X-Tenant-ID"); got != "tenant-a" {
+			t.Errorf("expected X-Tenant-ID header, got %q", got)
+		}
+		proxied.Store(true)
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := parseProxyURL(strings.Replace(proxy.URL, "http://", "http://user:secret@", 1))
+	if err != nil {
+		t.Fatalf("failed to parse proxy URL; %v", err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyURL(proxyURL)
+
+	client := &http.Client{Transport: newHeaderTransport(transport, map[string]string{"x-tenant-id": "tenant-a"})}
+	resp, err := client.Get("http://contextforge.invalid/tools")
+	if err != nil {
+		t.Fatalf("request failed; %v", err)
+	}
+	resp.Body.Close()
+
+	if !proxied.Load() {
+		t.Error("expected request to be sent through the proxy")
+	}
+
+	for _, raw := range []string{"ftp://proxy.example.com", "http://", "://bad"} {
+		if _, err := parseProxyURL(raw); err == nil {
+			t.Errorf("expected proxy_url %q to be rejected", raw)
+		}
+	}
+}
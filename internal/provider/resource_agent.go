@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -19,7 +21,11 @@ import (
 )
 
 type agentResource struct {
-	client *contextforge.Client
+	client            *contextforge.Client
+	defaultTags       []string
+	defaultTeamID     string
+	defaultVisibility string
+	readOnly          bool
 }
 
 // Force compile-time validation that agentResource satisfies the resource.Resource interface.
@@ -28,6 +34,9 @@ var _ resource.Resource = &agentResource{}
 // Force compile-time validation that agentResource satisfies the resource.ResourceWithImportState interface.
 var _ resource.ResourceWithImportState = &agentResource{}
 
+// Force compile-time validation that agentResource satisfies the resource.ResourceWithModifyPlan interface.
+var _ resource.ResourceWithModifyPlan = &agentResource{}
+
 // Force compile-time validation that agentResource satisfies the resource.ResourceWithConfigure interface.
 var _ resource.ResourceWithConfigure = &agentResource{}
 
@@ -49,6 +58,7 @@ type agentResourceModel struct {
 	AuthType        types.String  `tfsdk:"auth_type"`
 	Enabled         types.Bool    `tfsdk:"enabled"`
 	Tags            types.List    `tfsdk:"tags"`
+	TagsAll         types.List    `tfsdk:"tags_all"`
 	TeamID          types.String  `tfsdk:"team_id"`
 	Visibility      types.String  `tfsdk:"visibility"`
 
@@ -77,6 +87,8 @@ type agentResourceModel struct {
 	ImportBatchID     types.String `tfsdk:"import_batch_id"`
 	FederationSource  types.String `tfsdk:"federation_source"`
 	Version           types.Int64  `tfsdk:"version"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewAgentResource is a helper function to instantiate the agent resource.
@@ -176,6 +188,7 @@ func (r *agentResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"tags_all": tagsAllSchemaAttribute(),
 			"team_id": schema.StringAttribute{
 				MarkdownDescription: "Team ID",
 				Description:         "Team ID",
@@ -335,11 +348,34 @@ func (r *agentResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
+// ModifyPlan plans tags_all from the configured tags and the provider default tags, and
+// applies the provider default team and visibility to new agents.
+func (r *agentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTagsAll(ctx, req, resp, r.defaultTags)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modifyPlanTeamDefaults(ctx, req, resp, r.defaultTeamID, r.defaultVisibility)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *agentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "agent", &resp.Diagnostics) {
+		return
+	}
+
 	var data agentResourceModel
 
 	// Read plan
@@ -348,6 +384,15 @@ func (r *agentResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Build agent create struct with required fields
 	agent := &contextforge.AgentCreate{
 		Name:        data.Name.ValueString(),
@@ -388,14 +433,14 @@ func (r *agentResource) Create(ctx context.Context, req resource.CreateRequest,
 		agent.AuthType = &authType
 	}
 
-	// Tags
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		var tags []string
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		agent.Tags = tags
+	// Tags, merged with the provider default tags
+	tagNames, setTags, tagsDiags := requestTags(ctx, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if setTags {
+		agent.Tags = tagNames
 	}
 
 	// Build CreateOptions for team_id and visibility
@@ -440,6 +485,15 @@ func (r *agentResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get agent from API
 	agent, httpResp, err := r.client.Agents.Get(ctx, data.ID.ValueString())
 	if err != nil {
@@ -467,6 +521,10 @@ func (r *agentResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *agentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.readOnly, "update", "agent", &resp.Diagnostics) {
+		return
+	}
+
 	var data agentResourceModel
 
 	// Read plan
@@ -475,6 +533,15 @@ func (r *agentResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Build agent update struct (use three-state update semantics)
 	agent := &contextforge.AgentUpdate{}
 
@@ -523,14 +590,25 @@ func (r *agentResource) Update(ctx context.Context, req resource.UpdateRequest,
 		agent.AuthType = &authType
 	}
 
-	// Tags
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		var tags []string
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
+	// Tags, merged with the provider default tags
+	tagNames, setTags, tagsDiags := requestTags(ctx, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if setTags {
+		agent.Tags = tagNames
+	}
+
+	// An empty tag list is left out of the update request, so the tags are cleared first
+	if setTags && len(tagNames) == 0 {
+		if err := clearTags(ctx, r.client, "a2a/"+url.PathEscape(data.ID.ValueString())); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Clear Tags",
+				fmt.Sprintf("Unable to clear tags of agent with ID %s; %v", data.ID.ValueString(), err),
+			)
 			return
 		}
-		agent.Tags = tags
 	}
 
 	// Team/Visibility (Update uses agent struct directly, not options)
@@ -570,6 +648,10 @@ func (r *agentResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *agentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.readOnly, "delete", "agent", &resp.Diagnostics) {
+		return
+	}
+
 	var data agentResourceModel
 
 	// Read current state
@@ -578,6 +660,15 @@ func (r *agentResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete agent via API
 	httpResp, err := r.client.Agents.Delete(ctx, data.ID.ValueString())
 	if err != nil {
@@ -607,17 +698,21 @@ func (r *agentResource) Configure(ctx context.Context, req resource.ConfigureReq
 	}
 
 	// Type assert the provider data to the expected client type
-	client, ok := req.ProviderData.(*contextforge.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *contextforge.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	// Assign the client to the resource
-	r.client = client
+	r.client = data.Client
+	r.defaultTags = data.DefaultTags
+	r.defaultTeamID = data.DefaultTeamID
+	r.defaultVisibility = data.DefaultVisibility
+	r.readOnly = data.ReadOnly
 }
 
 // mapAgentToState is a helper to map Agent API response to Terraform state.
@@ -713,13 +808,10 @@ func (r *agentResource) mapAgentToState(ctx context.Context, agent *contextforge
 	}
 
 	// Map organizational fields
-	if agent.Tags != nil {
-		tagsList, tagsDiags := types.ListValueFrom(ctx, types.StringType, contextforge.TagNames(agent.Tags))
-		diags.Append(tagsDiags...)
-		data.Tags = tagsList
-	} else {
-		data.Tags = types.ListNull(types.StringType)
-	}
+	tags, tagsAll, tagsDiags := stateTags(ctx, agent.Tags, data.Tags, r.defaultTags)
+	diags.Append(tagsDiags...)
+	data.Tags = tags
+	data.TagsAll = tagsAll
 
 	data.TeamID = types.StringPointerValue(agent.TeamID)
 	data.OwnerEmail = types.StringPointerValue(agent.OwnerEmail)
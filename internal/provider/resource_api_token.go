@@ -0,0 +1,617 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+// apiToken is an API token as returned by the /tokens endpoints, which the SDK does not cover.
+type apiToken struct {
+	ID             string                  `json:"id"`
+	Name           string                  `json:"name"`
+	Description    *string                 `json:"description"`
+	UserEmail      string                  `json:"user_email"`
+	TeamID         *string                 `json:"team_id"`
+	ServerID       *string                 `json:"server_id"`
+	ResourceScopes []string                `json:"resource_scopes"`
+	IPRestrictions []string                `json:"ip_restrictions"`
+	IsActive       bool                    `json:"is_active"`
+	IsRevoked      bool                    `json:"is_revoked"`
+	CreatedAt      *contextforge.Timestamp `json:"created_at"`
+	ExpiresAt      *contextforge.Timestamp `json:"expires_at"`
+	LastUsed       *contextforge.Timestamp `json:"last_used"`
+}
+
+// apiTokenCreateResponse is the response of POST /tokens, the only response that
+// includes the token value.
+type apiTokenCreateResponse struct {
+	Token       apiToken `json:"token"`
+	AccessToken string   `json:"access_token"`
+}
+
+// apiTokenRequest is the request body for creating (POST) and updating (PUT) a token.
+// The team and expiry can only be set on creation.
+type apiTokenRequest struct {
+	Name          string                `json:"name"`
+	Description   *string               `json:"description,omitempty"`
+	ExpiresInDays *int64                `json:"expires_in_days,omitempty"`
+	TeamID        *string               `json:"team_id,omitempty"`
+	Scope         *apiTokenScopeRequest `json:"scope,omitempty"`
+}
+
+// apiTokenScopeRequest restricts what a token can be used for.
+type apiTokenScopeRequest struct {
+	ServerID       *string  `json:"server_id"`
+	Permissions    []string `json:"permissions"`
+	IPRestrictions []string `json:"ip_restrictions"`
+}
+
+type apiTokenResource struct {
+	client   *contextforge.Client
+	readOnly bool
+}
+
+// Force compile-time validation that apiTokenResource satisfies the resource.Resource interface.
+var _ resource.Resource = &apiTokenResource{}
+
+// Force compile-time validation that apiTokenResource satisfies the resource.ResourceWithConfigure interface.
+var _ resource.ResourceWithConfigure = &apiTokenResource{}
+
+// Force compile-time validation that apiTokenResource satisfies the resource.ResourceWithImportState interface.
+var _ resource.ResourceWithImportState = &apiTokenResource{}
+
+// apiTokenResourceModel defines the resource model.
+type apiTokenResourceModel struct {
+	// Computed field
+	ID types.String `tfsdk:"id"`
+
+	// Core fields
+	Name          types.String        `tfsdk:"name"`
+	Description   types.String        `tfsdk:"description"`
+	ExpiresInDays types.Int64         `tfsdk:"expires_in_days"`
+	Scope         *apiTokenScopeModel `tfsdk:"scope"`
+
+	// Computed fields
+	Token     types.String `tfsdk:"token"`
+	UserEmail types.String `tfsdk:"user_email"`
+	IsActive  types.Bool   `tfsdk:"is_active"`
+
+	// Timestamps
+	CreatedAt types.String `tfsdk:"created_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	LastUsed  types.String `tfsdk:"last_used"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// apiTokenScopeModel defines the scope block of the resource model.
+type apiTokenScopeModel struct {
+	TeamID         types.String `tfsdk:"team_id"`
+	ServerID       types.String `tfsdk:"server_id"`
+	Permissions    types.List   `tfsdk:"permissions"`
+	IPRestrictions types.List   `tfsdk:"ip_restrictions"`
+}
+
+// NewAPITokenResource is a helper function to instantiate the API token resource.
+func NewAPITokenResource() resource.Resource {
+	return &apiTokenResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *apiTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ContextForge API token owned by the provider's user",
+		Description:         "Manages a ContextForge API token owned by the provider's user",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed field
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Token unique identifier",
+				Description:         "Token unique identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Core fields
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Token name (unique per user)",
+				Description:         "Token name (unique per user)",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Token description",
+				Description:         "Token description",
+				Optional:            true,
+			},
+			"expires_in_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days until the token expires; the token does not expire when unset (changing it replaces the token)",
+				Description:         "Number of days until the token expires; the token does not expire when unset (changing it replaces the token)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed fields
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The token value, only available when the token is created",
+				Description:         "The token value, only available when the token is created",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user who owns the token",
+				Description:         "Email address of the user who owns the token",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the token is active",
+				Description:         "Whether the token is active",
+				Computed:            true,
+			},
+
+			// Timestamps
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp (RFC3339 format)",
+				Description:         "Creation timestamp (RFC3339 format)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiry timestamp (RFC3339 format)",
+				Description:         "Expiry timestamp (RFC3339 format)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used": schema.StringAttribute{
+				MarkdownDescription: "Last usage timestamp (RFC3339 format)",
+				Description:         "Last usage timestamp (RFC3339 format)",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"scope": schema.SingleNestedBlock{
+				MarkdownDescription: "Restrictions on what the token can be used for",
+				Description:         "Restrictions on what the token can be used for",
+				Attributes: map[string]schema.Attribute{
+					"team_id": schema.StringAttribute{
+						MarkdownDescription: "Team the token is scoped to (changing it replaces the token)",
+						Description:         "Team the token is scoped to (changing it replaces the token)",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"server_id": schema.StringAttribute{
+						MarkdownDescription: "Virtual server the token is limited to",
+						Description:         "Virtual server the token is limited to",
+						Optional:            true,
+					},
+					"permissions": schema.ListAttribute{
+						MarkdownDescription: "Permissions granted to the token (for example tools.read); all of the user's permissions when unset",
+						Description:         "Permissions granted to the token (for example tools.read); all of the user's permissions when unset",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"ip_restrictions": schema.ListAttribute{
+						MarkdownDescription: "IP addresses or CIDR ranges the token can be used from",
+						Description:         "IP addresses or CIDR ranges the token can be used from",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *apiTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "API token", &resp.Diagnostics) {
+		return
+	}
+
+	var data apiTokenResourceModel
+
+	// Read plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	body, diags := apiTokenRequestFromPlan(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body.ExpiresInDays = data.ExpiresInDays.ValueInt64Pointer()
+	if data.Scope != nil {
+		body.TeamID = data.Scope.TeamID.ValueStringPointer()
+	}
+
+	// Create the token
+	httpReq, err := r.client.NewRequest(http.MethodPost, "tokens", body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create API Token",
+			fmt.Sprintf("Unable to create API token %s; %v", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	var created apiTokenCreateResponse
+	if _, err := r.client.Do(ctx, httpReq, &created); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create API Token",
+			fmt.Sprintf("Unable to create API token %s; %v", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	// The token value is only returned on creation
+	data.Token = types.StringValue(created.AccessToken)
+
+	// Map response to state
+	resp.Diagnostics.Append(mapAPITokenToState(ctx, &created.Token, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *apiTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data apiTokenResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	token, httpResp, err := r.getToken(ctx, data.ID.ValueString())
+	if err != nil {
+		// Handle 404 - resource no longer exists
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Read API Token",
+			fmt.Sprintf("Unable to read API token with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// A token revoked outside Terraform cannot be restored, so it is recreated
+	if token.IsRevoked {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Map response to state
+	resp.Diagnostics.Append(mapAPITokenToState(ctx, token, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *apiTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.readOnly, "update", "API token", &resp.Diagnostics) {
+		return
+	}
+
+	var data apiTokenResourceModel
+
+	// Read plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	body, diags := apiTokenRequestFromPlan(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Clear the description and scope when they are removed from the configuration
+	if body.Description == nil {
+		body.Description = new(string)
+	}
+	if body.Scope == nil {
+		body.Scope = &apiTokenScopeRequest{Permissions: []string{}, IPRestrictions: []string{}}
+	}
+
+	// Update the token
+	httpReq, err := r.client.NewRequest(http.MethodPut, "tokens/"+url.PathEscape(data.ID.ValueString()), body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update API Token",
+			fmt.Sprintf("Unable to update API token with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	var token apiToken
+	if _, err := r.client.Do(ctx, httpReq, &token); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update API Token",
+			fmt.Sprintf("Unable to update API token with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// Map response to state
+	resp.Diagnostics.Append(mapAPITokenToState(ctx, &token, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete revokes the token and removes the Terraform state on success.
+func (r *apiTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.readOnly, "delete", "API token", &resp.Diagnostics) {
+		return
+	}
+
+	var data apiTokenResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Revoke the token
+	httpReq, err := r.client.NewRequest(http.MethodDelete, "tokens/"+url.PathEscape(data.ID.ValueString()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Revoke API Token",
+			fmt.Sprintf("Unable to revoke API token with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	httpResp, err := r.client.Do(ctx, httpReq, nil)
+	if err != nil {
+		// Ignore 404 errors (resource already deleted)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Revoke API Token",
+			fmt.Sprintf("Unable to revoke API token with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+// ImportState imports an existing token by ID. The token value is only returned on
+// creation, so token is null for imported tokens.
+func (r *apiTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getToken retrieves a token of the provider's user by ID.
+func (r *apiTokenResource) getToken(ctx context.Context, tokenID string) (*apiToken, *contextforge.Response, error) {
+	req, err := r.client.NewRequest(http.MethodGet, "tokens/"+url.PathEscape(tokenID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var token apiToken
+	resp, err := r.client.Do(ctx, req, &token)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &token, resp, nil
+}
+
+// apiTokenRequestFromPlan builds the fields of a token request that can be set on both
+// creation and update.
+func apiTokenRequestFromPlan(ctx context.Context, data *apiTokenResourceModel) (*apiTokenRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := &apiTokenRequest{
+		Name: data.Name.ValueString(),
+	}
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		body.Description = data.Description.ValueStringPointer()
+	}
+
+	if data.Scope == nil {
+		return body, diags
+	}
+
+	scope := &apiTokenScopeRequest{
+		Permissions:    []string{},
+		IPRestrictions: []string{},
+	}
+	if !data.Scope.ServerID.IsNull() && !data.Scope.ServerID.IsUnknown() {
+		scope.ServerID = data.Scope.ServerID.ValueStringPointer()
+	}
+	if !data.Scope.Permissions.IsNull() && !data.Scope.Permissions.IsUnknown() {
+		diags.Append(data.Scope.Permissions.ElementsAs(ctx, &scope.Permissions, false)...)
+	}
+	if !data.Scope.IPRestrictions.IsNull() && !data.Scope.IPRestrictions.IsUnknown() {
+		diags.Append(data.Scope.IPRestrictions.ElementsAs(ctx, &scope.IPRestrictions, false)...)
+	}
+	body.Scope = scope
+
+	return body, diags
+}
+
+// mapAPITokenToState maps an API token to the Terraform state model. The scope block is
+// kept null when it is not configured and the token is unrestricted.
+func mapAPITokenToState(ctx context.Context, token *apiToken, data *apiTokenResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(token.ID)
+	data.Name = types.StringValue(token.Name)
+
+	if token.Description != nil && *token.Description != "" {
+		data.Description = types.StringPointerValue(token.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	data.UserEmail = types.StringValue(token.UserEmail)
+	data.IsActive = types.BoolValue(token.IsActive)
+
+	// Note: Token is only returned on creation and expires_in_days is not returned by
+	// API - keep existing state values
+
+	restricted := token.TeamID != nil || token.ServerID != nil || len(token.ResourceScopes) > 0 || len(token.IPRestrictions) > 0
+	if data.Scope != nil || restricted {
+		scope := &apiTokenScopeModel{
+			TeamID:   types.StringPointerValue(token.TeamID),
+			ServerID: types.StringPointerValue(token.ServerID),
+		}
+
+		var prior apiTokenScopeModel
+		if data.Scope != nil {
+			prior = *data.Scope
+		}
+
+		var d diag.Diagnostics
+		scope.Permissions, d = apiTokenScopeList(ctx, token.ResourceScopes, prior.Permissions)
+		diags.Append(d...)
+		scope.IPRestrictions, d = apiTokenScopeList(ctx, token.IPRestrictions, prior.IPRestrictions)
+		diags.Append(d...)
+
+		data.Scope = scope
+	}
+
+	if token.CreatedAt != nil && !token.CreatedAt.Time.IsZero() {
+		data.CreatedAt = types.StringValue(token.CreatedAt.Time.Format(time.RFC3339))
+	} else {
+		data.CreatedAt = types.StringNull()
+	}
+
+	if token.ExpiresAt != nil && !token.ExpiresAt.Time.IsZero() {
+		data.ExpiresAt = types.StringValue(token.ExpiresAt.Time.Format(time.RFC3339))
+	} else {
+		data.ExpiresAt = types.StringNull()
+	}
+
+	if token.LastUsed != nil && !token.LastUsed.Time.IsZero() {
+		data.LastUsed = types.StringValue(token.LastUsed.Time.Format(time.RFC3339))
+	} else {
+		data.LastUsed = types.StringNull()
+	}
+
+	return diags
+}
+
+// apiTokenScopeList converts a scope list returned by the API, keeping it null when it is
+// empty and was not configured.
+func apiTokenScopeList(ctx context.Context, values []string, prior types.List) (types.List, diag.Diagnostics) {
+	if len(values) == 0 && (prior.IsNull() || prior.IsUnknown()) {
+		return types.ListNull(types.StringType), nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
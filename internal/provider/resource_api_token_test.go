@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccAPITokenResource_basic tests the full lifecycle for an API token resource.
+// This test verifies:
+//   - Create returns the token value and expiry
+//   - Update of the description and scope in place
+//   - Import using the token ID
+//   - Delete revokes the token
+//
+// Prerequisites:
+//   - CONTEXTFORGE_ADDR environment variable set
+//   - CONTEXTFORGE_TOKEN environment variable set
+//
+// To run:
+//   make integration-test-all  # Full lifecycle with setup/teardown
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccAPITokenResource_basic
+func TestAccAPITokenResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccAPITokenResourceConfig("Automation token", "tools.read"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("contextforge_api_token.test", "id"),
+					resource.TestCheckResourceAttr("contextforge_api_token.test", "name", "tf-test-api-token"),
+					resource.TestCheckResourceAttr("contextforge_api_token.test", "description", "Automation token"),
+					resource.TestCheckResourceAttr("contextforge_api_token.test", "expires_in_days", "30"),
+					resource.TestCheckResourceAttrPair("contextforge_api_token.test", "scope.team_id", "contextforge_team.test", "id"),
+					resource.TestCheckResourceAttr("contextforge_api_token.test", "scope.permissions.#", "1"),
+					resource.TestCheckResourceAttr("contextforge_api_token.test", "scope.permissions.0", "tools.read"),
+					resource.TestCheckResourceAttr("contextforge_api_token.test", "scope.ip_restrictions.0", "10.0.0.0/8"),
+					resource.TestCheckResourceAttrSet("contextforge_api_token.test", "token"),
+					resource.TestCheckResourceAttrSet("contextforge_api_token.test", "user_email"),
+					resource.TestCheckResourceAttrSet("contextforge_api_token.test", "expires_at"),
+					resource.TestCheckResourceAttr("contextforge_api_token.test", "is_active", "true"),
+				),
+			},
+			// Update testing
+			{
+				Config: testAccAPITokenResourceConfig("Automation token (updated)", "tools.execute"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("contextforge_api_token.test", "description", "Automation token (updated)"),
+					resource.TestCheckResourceAttr("contextforge_api_token.test", "scope.permissions.0", "tools.execute"),
+					resource.TestCheckResourceAttrSet("contextforge_api_token.test", "token"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:            "contextforge_api_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"token", "expires_in_days"}, // only available on creation
+			},
+		},
+	})
+}
+
+// TestAccAPITokenResource_unscoped tests a token without a scope block.
+//
+// To run:
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccAPITokenResource_unscoped
+func TestAccAPITokenResource_unscoped(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "contextforge_api_token" "test" {
+  name = "tf-test-api-token-unscoped"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("contextforge_api_token.test", "token"),
+					resource.TestCheckNoResourceAttr("contextforge_api_token.test", "scope.team_id"),
+					resource.TestCheckNoResourceAttr("contextforge_api_token.test", "expires_at"),
+				),
+			},
+		},
+	})
+}
+
+// testAccAPITokenResourceConfig generates Terraform configuration that creates a team and
+// a token scoped to it.
+//
+// Parameters:
+//   - description: Token description
+//   - permission: Single permission granted to the token
+//
+// Returns:
+//   - HCL configuration string
+func testAccAPITokenResourceConfig(description, permission string) string {
+	return fmt.Sprintf(`
+resource "contextforge_team" "test" {
+  name = "tf-test-team-api-token"
+}
+
+resource "contextforge_api_token" "test" {
+  name            = "tf-test-api-token"
+  description     = %[1]q
+  expires_in_days = 30
+
+  scope {
+    team_id         = contextforge_team.test.id
+    permissions     = [%[2]q]
+    ip_restrictions = ["10.0.0.0/8"]
+  }
+}
+`, description, permission)
+}
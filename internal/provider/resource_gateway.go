@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,13 +20,18 @@ import (
 )
 
 type gatewayResource struct {
-	client *contextforge.Client
+	client            *contextforge.Client
+	defaultTags       []string
+	defaultTeamID     string
+	defaultVisibility string
+	readOnly          bool
 }
 
 // Force compile-time validation
 var _ resource.Resource = &gatewayResource{}
 var _ resource.ResourceWithConfigure = &gatewayResource{}
 var _ resource.ResourceWithImportState = &gatewayResource{}
+var _ resource.ResourceWithModifyPlan = &gatewayResource{}
 
 // gatewayResourceModel defines the resource model (same as data source minus lookup-only semantics)
 type gatewayResourceModel struct {
@@ -54,6 +61,7 @@ type gatewayResourceModel struct {
 
 	// Organizational fields
 	Tags       types.List   `tfsdk:"tags"`
+	TagsAll    types.List   `tfsdk:"tags_all"`
 	TeamID     types.String `tfsdk:"team_id"`
 	Team       types.String `tfsdk:"team"`
 	OwnerEmail types.String `tfsdk:"owner_email"`
@@ -77,6 +85,8 @@ type gatewayResourceModel struct {
 	FederationSource  types.String `tfsdk:"federation_source"`
 	Version           types.Int64  `tfsdk:"version"`
 	Slug              types.String `tfsdk:"slug"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewGatewayResource is a helper function to instantiate the gateway resource.
@@ -217,6 +227,7 @@ func (r *gatewayResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Optional:            true,
 				Computed:            true,
 			},
+			"tags_all": tagsAllSchemaAttribute(),
 			"team_id": schema.StringAttribute{
 				MarkdownDescription: "Team ID",
 				Description:         "Team ID",
@@ -319,11 +330,34 @@ func (r *gatewayResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan plans tags_all from the configured tags and the provider default tags, and
+// applies the provider default team and visibility to new gateways.
+func (r *gatewayResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTagsAll(ctx, req, resp, r.defaultTags)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	modifyPlanTeamDefaults(ctx, req, resp, r.defaultTeamID, r.defaultVisibility)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *gatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "gateway", &resp.Diagnostics) {
+		return
+	}
+
 	var data gatewayResourceModel
 
 	// Read plan
@@ -332,6 +366,15 @@ func (r *gatewayResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Build gateway object for API
 	gateway := &contextforge.Gateway{
 		Name:      data.Name.ValueString(),
@@ -425,14 +468,14 @@ func (r *gatewayResource) Create(ctx context.Context, req resource.CreateRequest
 		gateway.OAuthConfig = oauthMap
 	}
 
-	// Map optional tags
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		var tags []string
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		gateway.Tags = contextforge.NewTags(tags)
+	// Map optional tags, merged with the provider default tags
+	tagNames, setTags, tagsDiags := requestTags(ctx, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if setTags {
+		gateway.Tags = contextforge.NewTags(tagNames)
 	}
 
 	// Prepare create options for team_id and visibility (like Tool resource)
@@ -480,6 +523,15 @@ func (r *gatewayResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get gateway from API
 	gateway, httpResp, err := r.client.Gateways.Get(ctx, data.ID.ValueString())
 	if err != nil {
@@ -507,6 +559,10 @@ func (r *gatewayResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *gatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.readOnly, "update", "gateway", &resp.Diagnostics) {
+		return
+	}
+
 	var data gatewayResourceModel
 
 	// Read plan
@@ -515,6 +571,15 @@ func (r *gatewayResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Build gateway object for API (similar to Create but for Update endpoint)
 	gateway := &contextforge.Gateway{
 		Name:      data.Name.ValueString(),
@@ -605,14 +670,25 @@ func (r *gatewayResource) Update(ctx context.Context, req resource.UpdateRequest
 		gateway.OAuthConfig = oauthMap
 	}
 
-	// Tags
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		var tags []string
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
+	// Tags, merged with the provider default tags
+	tagNames, setTags, tagsDiags := requestTags(ctx, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if setTags {
+		gateway.Tags = contextforge.NewTags(tagNames)
+	}
+
+	// An empty tag list is left out of the update request, so the tags are cleared first
+	if setTags && len(tagNames) == 0 {
+		if err := clearTags(ctx, r.client, "gateways/"+url.PathEscape(data.ID.ValueString())); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Clear Tags",
+				fmt.Sprintf("Unable to clear tags of gateway with ID %s; %v", data.ID.ValueString(), err),
+			)
 			return
 		}
-		gateway.Tags = contextforge.NewTags(tags)
 	}
 
 	// Team/Visibility (Update uses gateway struct directly, not options)
@@ -648,6 +724,10 @@ func (r *gatewayResource) Update(ctx context.Context, req resource.UpdateRequest
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *gatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.readOnly, "delete", "gateway", &resp.Diagnostics) {
+		return
+	}
+
 	var data gatewayResourceModel
 
 	// Read current state
@@ -656,6 +736,15 @@ func (r *gatewayResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete gateway via API
 	httpResp, err := r.client.Gateways.Delete(ctx, data.ID.ValueString())
 	if err != nil {
@@ -680,17 +769,21 @@ func (r *gatewayResource) Configure(ctx context.Context, req resource.ConfigureR
 	}
 
 	// Type assert the provider data to the expected client type
-	client, ok := req.ProviderData.(*contextforge.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *contextforge.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	// Assign the client to the resource
-	r.client = client
+	r.client = data.Client
+	r.defaultTags = data.DefaultTags
+	r.defaultTeamID = data.DefaultTeamID
+	r.defaultVisibility = data.DefaultVisibility
+	r.readOnly = data.ReadOnly
 }
 
 // ImportState imports an existing resource by ID.
@@ -775,13 +868,10 @@ func (r *gatewayResource) mapGatewayToState(ctx context.Context, gateway *contex
 	}
 
 	// Tags
-	if gateway.Tags != nil {
-		tagsList, diagsList := types.ListValueFrom(ctx, types.StringType, contextforge.TagNames(gateway.Tags))
-		diags.Append(diagsList...)
-		data.Tags = tagsList
-	} else {
-		data.Tags = types.ListNull(types.StringType)
-	}
+	tags, tagsAll, tagsDiags := stateTags(ctx, gateway.Tags, data.Tags, r.defaultTags)
+	diags.Append(tagsDiags...)
+	data.Tags = tags
+	data.TagsAll = tagsAll
 
 	// Organizational fields
 	data.TeamID = types.StringPointerValue(gateway.TeamID)
@@ -0,0 +1,593 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+	"github.com/leefowlercu/terraform-provider-contextforge/internal/tfconv"
+)
+
+// gatewayTool is a tool as returned by the tools API, including the federation fields
+// that the SDK's Tool type does not expose.
+type gatewayTool struct {
+	ID           string                  `json:"id"`
+	Name         string                  `json:"name"`
+	OriginalName string                  `json:"originalName"`
+	DisplayName  *string                 `json:"displayName,omitempty"`
+	Description  *string                 `json:"description,omitempty"`
+	GatewayID    *string                 `json:"gatewayId,omitempty"`
+	Enabled      bool                    `json:"enabled"`
+	Annotations  map[string]any          `json:"annotations,omitempty"`
+	Tags         []contextforge.Tag      `json:"tags,omitempty"`
+	CreatedAt    *contextforge.Timestamp `json:"createdAt,omitempty"`
+	UpdatedAt    *contextforge.Timestamp `json:"updatedAt,omitempty"`
+}
+
+// gatewayToolUpdate is the request body for overriding the fields of a discovered tool.
+// Annotations and tags are pointers so that a configured empty value is sent, clearing
+// the discovered or previously overridden value, while an unset one is left out.
+type gatewayToolUpdate struct {
+	DisplayName *string         `json:"displayName,omitempty"`
+	Description *string         `json:"description,omitempty"`
+	Annotations *map[string]any `json:"annotations,omitempty"`
+	Tags        *[]string       `json:"tags,omitempty"`
+}
+
+type gatewayToolResource struct {
+	client      *contextforge.Client
+	defaultTags []string
+	readOnly    bool
+}
+
+// Force compile-time validation that gatewayToolResource satisfies the resource.Resource interface.
+var _ resource.Resource = &gatewayToolResource{}
+
+// Force compile-time validation that gatewayToolResource satisfies the resource.ResourceWithConfigure interface.
+var _ resource.ResourceWithConfigure = &gatewayToolResource{}
+
+// Force compile-time validation that gatewayToolResource satisfies the resource.ResourceWithImportState interface.
+var _ resource.ResourceWithImportState = &gatewayToolResource{}
+
+// Force compile-time validation that gatewayToolResource satisfies the resource.ResourceWithModifyPlan interface.
+var _ resource.ResourceWithModifyPlan = &gatewayToolResource{}
+
+// gatewayToolResourceModel defines the resource model.
+type gatewayToolResourceModel struct {
+	// Computed field
+	ID types.String `tfsdk:"id"`
+
+	// Core fields
+	GatewayID    types.String `tfsdk:"gateway_id"`
+	OriginalName types.String `tfsdk:"original_name"`
+
+	// Overridable fields
+	DisplayName types.String  `tfsdk:"display_name"`
+	Description types.String  `tfsdk:"description"`
+	Enabled     types.Bool    `tfsdk:"enabled"`
+	Annotations types.Dynamic `tfsdk:"annotations"`
+	Tags        types.List    `tfsdk:"tags"`
+	TagsAll     types.List    `tfsdk:"tags_all"`
+
+	// Computed fields
+	Name types.String `tfsdk:"name"`
+
+	// Timestamps
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewGatewayToolResource is a helper function to instantiate the gateway tool resource.
+func NewGatewayToolResource() resource.Resource {
+	return &gatewayToolResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *gatewayToolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_tool"
+}
+
+// Schema defines the schema for the resource.
+func (r *gatewayToolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the overridable fields of a tool discovered from a ContextForge gateway. Destroying the resource releases management without deleting the tool",
+		Description:         "Manages the overridable fields of a tool discovered from a ContextForge gateway. Destroying the resource releases management without deleting the tool",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed field
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Tool ID",
+				Description:         "Tool ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Core fields
+			"gateway_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the gateway the tool was discovered from",
+				Description:         "ID of the gateway the tool was discovered from",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"original_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the tool as reported by the gateway's MCP server",
+				Description:         "Name of the tool as reported by the gateway's MCP server",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Overridable fields
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Display name shown in the UI (default: left as discovered)",
+				Description:         "Display name shown in the UI (default: left as discovered)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Tool description (default: left as discovered)",
+				Description:         "Tool description (default: left as discovered)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the tool is enabled (default: left as discovered)",
+				Description:         "Whether the tool is enabled (default: left as discovered)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"annotations": schema.DynamicAttribute{
+				MarkdownDescription: "MCP tool annotations, such as `readOnlyHint` or `destructiveHint` (default: left as discovered)",
+				Description:         "MCP tool annotations, such as readOnlyHint or destructiveHint (default: left as discovered)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Dynamic{
+					dynamicplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Tool tags",
+				Description:         "Tool tags",
+				Optional:            true,
+				Computed:            true,
+			},
+			"tags_all": tagsAllSchemaAttribute(),
+
+			// Computed fields
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Tool name, as exposed by the gateway",
+				Description:         "Tool name, as exposed by the gateway",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Timestamps
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp (RFC3339 format)",
+				Description:         "Creation timestamp (RFC3339 format)",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp (RFC3339 format)",
+				Description:         "Last update timestamp (RFC3339 format)",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan plans tags_all from the configured tags and the provider default tags.
+func (r *gatewayToolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTagsAll(ctx, req, resp, r.defaultTags)
+}
+
+// Create adopts the discovered tool, applies the configured overrides and sets the initial
+// Terraform state.
+func (r *gatewayToolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "gateway tool", &resp.Diagnostics) {
+		return
+	}
+
+	var data gatewayToolResourceModel
+
+	// Read plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Find the discovered tool
+	tool, err := findGatewayTool(ctx, r.client, data.GatewayID.ValueString(), data.OriginalName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to List Tools",
+			fmt.Sprintf("Unable to list tools; %v", err),
+		)
+		return
+	}
+	if tool == nil {
+		resp.Diagnostics.AddError(
+			"Gateway Tool Not Found",
+			fmt.Sprintf("No tool named %q was discovered from gateway %s.", data.OriginalName.ValueString(), data.GatewayID.ValueString()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(tool.ID)
+
+	// Apply the configured overrides
+	tool, diags = r.applyOverrides(ctx, &data, tool)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapGatewayToolToState(ctx, tool, &data, true)...)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *gatewayToolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data gatewayToolResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tool, httpResp, err := getGatewayTool(ctx, r.client, data.ID.ValueString())
+	if err != nil {
+		// Handle 404 - tool no longer exists
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Read Tool",
+			fmt.Sprintf("Unable to read tool with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapGatewayToolToState(ctx, tool, &data, false)...)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update applies the configured overrides and sets the updated Terraform state on success.
+func (r *gatewayToolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.readOnly, "update", "gateway tool", &resp.Diagnostics) {
+		return
+	}
+
+	var data gatewayToolResourceModel
+
+	// Read plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	tool, _, err := getGatewayTool(ctx, r.client, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Tool",
+			fmt.Sprintf("Unable to read tool with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tool, diags = r.applyOverrides(ctx, &data, tool)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapGatewayToolToState(ctx, tool, &data, true)...)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete releases management of the tool. The tool belongs to its gateway, so it is left
+// in place with its current overrides, and no request is sent.
+func (r *gatewayToolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// State is automatically removed by the framework
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *gatewayToolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+	r.defaultTags = data.DefaultTags
+	r.readOnly = data.ReadOnly
+}
+
+// ImportState imports a discovered tool by ID.
+func (r *gatewayToolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applyOverrides sends the configured overrides of a discovered tool and returns the tool
+// as read back from the API. Attributes left unset in the configuration are not sent, so
+// they keep their discovered values.
+func (r *gatewayToolResource) applyOverrides(ctx context.Context, data *gatewayToolResourceModel, tool *gatewayTool) (*gatewayTool, diag.Diagnostics) {
+	body, diags := gatewayToolUpdateFromModel(ctx, data, r.defaultTags)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if body != nil {
+		req, err := r.client.NewRequest(http.MethodPut, "tools/"+url.PathEscape(tool.ID), body)
+		if err == nil {
+			_, err = r.client.Do(ctx, req, nil)
+		}
+		if err != nil {
+			diags.AddError(
+				"Failed to Update Tool",
+				fmt.Sprintf("Unable to update tool with ID %s; %v", tool.ID, err),
+			)
+			return nil, diags
+		}
+	}
+
+	// Enabled state is changed through the toggle endpoint
+	if !data.Enabled.IsNull() && !data.Enabled.IsUnknown() && data.Enabled.ValueBool() != tool.Enabled {
+		if _, _, err := r.client.Tools.Toggle(ctx, tool.ID, data.Enabled.ValueBool()); err != nil {
+			diags.AddError(
+				"Failed to Toggle Tool",
+				fmt.Sprintf("Unable to set enabled to %t for tool with ID %s; %v", data.Enabled.ValueBool(), tool.ID, err),
+			)
+			return nil, diags
+		}
+	}
+
+	// Read the tool back, as the update endpoints do not return it reliably
+	updated, _, err := getGatewayTool(ctx, r.client, tool.ID)
+	if err != nil {
+		diags.AddError(
+			"Failed to Read Updated Tool",
+			fmt.Sprintf("Unable to read tool after update (ID: %s); %v", tool.ID, err),
+		)
+		return nil, diags
+	}
+
+	return updated, diags
+}
+
+// gatewayToolUpdateFromModel builds the request body for the configured overrides of a
+// discovered tool. Returns nil when no override is configured.
+func gatewayToolUpdateFromModel(ctx context.Context, data *gatewayToolResourceModel, defaultTags []string) (*gatewayToolUpdate, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := &gatewayToolUpdate{}
+	send := false
+
+	if !data.DisplayName.IsNull() && !data.DisplayName.IsUnknown() {
+		body.DisplayName = data.DisplayName.ValueStringPointer()
+		send = true
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		body.Description = data.Description.ValueStringPointer()
+		send = true
+	}
+
+	if !data.Annotations.IsNull() && !data.Annotations.IsUnknown() {
+		annotations, err := tfconv.ConvertObjectValueToMap(ctx, data.Annotations.UnderlyingValue())
+		if err != nil {
+			diags.AddError(
+				"Failed to Convert Annotations",
+				fmt.Sprintf("Unable to convert annotations from object value; %v", err),
+			)
+			return nil, diags
+		}
+		if annotations == nil {
+			annotations = map[string]any{}
+		}
+		body.Annotations = &annotations
+		send = true
+	}
+
+	// Tags, merged with the provider default tags
+	tagNames, setTags, tagsDiags := requestTags(ctx, data.Tags, defaultTags)
+	diags.Append(tagsDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if setTags {
+		body.Tags = &tagNames
+		send = true
+	}
+
+	if !send {
+		return nil, diags
+	}
+	return body, diags
+}
+
+// mapGatewayToolToState maps a discovered tool to the Terraform state model. When
+// keepPlannedAnnotations is set, configured annotations are kept as planned, so that
+// differences in how the API echoes them back do not produce an inconsistent result.
+func (r *gatewayToolResource) mapGatewayToolToState(ctx context.Context, tool *gatewayTool, data *gatewayToolResourceModel, keepPlannedAnnotations bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(tool.ID)
+	data.GatewayID = types.StringPointerValue(tool.GatewayID)
+	data.OriginalName = types.StringValue(tool.OriginalName)
+	data.Name = types.StringValue(tool.Name)
+	data.DisplayName = types.StringPointerValue(tool.DisplayName)
+	data.Description = types.StringPointerValue(tool.Description)
+	data.Enabled = types.BoolValue(tool.Enabled)
+
+	// Map annotations (map[string]any -> types.Dynamic)
+	if !keepPlannedAnnotations || data.Annotations.IsNull() || data.Annotations.IsUnknown() {
+		if len(tool.Annotations) > 0 {
+			annotationsValue, err := tfconv.ConvertMapToObjectValue(ctx, tool.Annotations)
+			if err != nil {
+				diags.AddError(
+					"Failed to Convert Annotations",
+					fmt.Sprintf("Unable to convert annotations to object value; %v", err),
+				)
+				return diags
+			}
+			data.Annotations = types.DynamicValue(annotationsValue)
+		} else {
+			data.Annotations = types.DynamicNull()
+		}
+	}
+
+	// Map tags
+	tags, tagsAll, tagsDiags := stateTags(ctx, tool.Tags, data.Tags, r.defaultTags)
+	diags.Append(tagsDiags...)
+	data.Tags = tags
+	data.TagsAll = tagsAll
+
+	// Map timestamps
+	if tool.CreatedAt != nil && !tool.CreatedAt.Time.IsZero() {
+		data.CreatedAt = types.StringValue(tool.CreatedAt.Time.Format(time.RFC3339))
+	} else {
+		data.CreatedAt = types.StringNull()
+	}
+
+	if tool.UpdatedAt != nil && !tool.UpdatedAt.Time.IsZero() {
+		data.UpdatedAt = types.StringValue(tool.UpdatedAt.Time.Format(time.RFC3339))
+	} else {
+		data.UpdatedAt = types.StringNull()
+	}
+
+	return diags
+}
+
+// getGatewayTool reads a tool by ID, including its federation fields.
+func getGatewayTool(ctx context.Context, client *contextforge.Client, toolID string) (*gatewayTool, *contextforge.Response, error) {
+	req, err := client.NewRequest(http.MethodGet, "tools/"+url.PathEscape(toolID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tool *gatewayTool
+	resp, err := client.Do(ctx, req, &tool)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tool, resp, nil
+}
+
+// findGatewayTool looks up the tool discovered from a gateway under its original name,
+// following the cursor of the tools list. Returns nil without an error when no such tool
+// exists.
+func findGatewayTool(ctx context.Context, client *contextforge.Client, gatewayID, originalName string) (*gatewayTool, error) {
+	cursor := ""
+	for {
+		u := "tools?include_inactive=true"
+		if cursor != "" {
+			u += "&cursor=" + url.QueryEscape(cursor)
+		}
+
+		req, err := client.NewRequest(http.MethodGet, u, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var tools []*gatewayTool
+		resp, err := client.Do(ctx, req, &tools)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tool := range tools {
+			if tool.GatewayID != nil && *tool.GatewayID == gatewayID && tool.OriginalName == originalName {
+				return tool, nil
+			}
+		}
+
+		if resp.NextCursor == "" {
+			return nil, nil
+		}
+		cursor = resp.NextCursor
+	}
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccGatewayToolResource_basic tests adopting a tool discovered from a gateway.
+// This test verifies:
+//   - Create adopts the tool by gateway ID and original name and applies the overrides
+//   - Update of the overrides in place, including disabling the tool
+//   - Import using the tool ID
+//   - Destroy releases the tool instead of deleting it, so it can be adopted again
+//
+// Prerequisites:
+//   - CONTEXTFORGE_ADDR environment variable set
+//   - CONTEXTFORGE_TOKEN environment variable set
+//   - Integration test setup completed (creates test gateway for the MCP time server)
+//
+// To run:
+//   make integration-test-all  # Full lifecycle with setup/teardown
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccGatewayToolResource_basic
+func TestAccGatewayToolResource_basic(t *testing.T) {
+	gatewayID := testAccGetGatewayID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGatewayToolResourceConfig(gatewayID, "Current Time", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("contextforge_gateway_tool.test", "id"),
+					resource.TestCheckResourceAttrSet("contextforge_gateway_tool.test", "name"),
+					resource.TestCheckResourceAttr("contextforge_gateway_tool.test", "gateway_id", gatewayID),
+					resource.TestCheckResourceAttr("contextforge_gateway_tool.test", "original_name", "get_current_time"),
+					resource.TestCheckResourceAttr("contextforge_gateway_tool.test", "display_name", "Current Time"),
+					resource.TestCheckResourceAttr("contextforge_gateway_tool.test", "description", "Returns the current time in a timezone"),
+					resource.TestCheckResourceAttr("contextforge_gateway_tool.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("contextforge_gateway_tool.test", "annotations.readOnlyHint", "true"),
+					resource.TestCheckResourceAttr("contextforge_gateway_tool.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("contextforge_gateway_tool.test", "tags.0", "time"),
+				),
+			},
+			// Update testing
+			{
+				Config: testAccGatewayToolResourceConfig(gatewayID, "Clock", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("contextforge_gateway_tool.test", "display_name", "Clock"),
+					resource.TestCheckResourceAttr("contextforge_gateway_tool.test", "enabled", "false"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:      "contextforge_gateway_tool.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Destroy releases the tool, which is adopted again
+			{
+				Config: fmt.Sprintf(`
+resource "contextforge_gateway_tool" "again" {
+  gateway_id    = %[1]q
+  original_name = "get_current_time"
+  enabled       = true
+}
+`, gatewayID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("contextforge_gateway_tool.again", "id"),
+					resource.TestCheckResourceAttr("contextforge_gateway_tool.again", "display_name", "Clock"),
+					resource.TestCheckResourceAttr("contextforge_gateway_tool.again", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccGatewayToolResource_notFound tests that adopting an unknown tool fails.
+//
+// To run:
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccGatewayToolResource_notFound
+func TestAccGatewayToolResource_notFound(t *testing.T) {
+	gatewayID := testAccGetGatewayID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "contextforge_gateway_tool" "test" {
+  gateway_id    = %[1]q
+  original_name = "tf-test-nonexistent-tool"
+}
+`, gatewayID),
+				ExpectError: regexp.MustCompile(`Gateway Tool Not Found`),
+			},
+		},
+	})
+}
+
+// testAccGatewayToolResourceConfig generates Terraform configuration that adopts the
+// get_current_time tool of the test gateway.
+//
+// Parameters:
+//   - gatewayID: ID of the test gateway
+//   - displayName: Display name override
+//   - enabled: Whether the tool is enabled
+//
+// Returns:
+//   - HCL configuration string
+func testAccGatewayToolResourceConfig(gatewayID, displayName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "contextforge_gateway_tool" "test" {
+  gateway_id    = %[1]q
+  original_name = "get_current_time"
+  display_name  = %[2]q
+  description   = "Returns the current time in a timezone"
+  enabled       = %[3]t
+  tags          = ["time"]
+
+  annotations = {
+    readOnlyHint = true
+  }
+}
+`, gatewayID, displayName, enabled)
+}
+
+// TestGatewayToolUpdateFromModel verifies the JSON body sent for gateway tool overrides,
+// in particular that configured empty tags and annotations are sent to clear them.
+//
+// To run:
+//
+//	go test -v ./internal/provider/ -run TestGatewayToolUpdateFromModel
+func TestGatewayToolUpdateFromModel(t *testing.T) {
+	ctx := context.Background()
+
+	emptyTags := types.ListValueMust(types.StringType, []attr.Value{})
+	emptyAnnotations := types.DynamicValue(types.ObjectValueMust(map[string]attr.Type{}, map[string]attr.Value{}))
+	annotations := types.DynamicValue(types.ObjectValueMust(
+		map[string]attr.Type{"readOnlyHint": types.BoolType},
+		map[string]attr.Value{"readOnlyHint": types.BoolValue(true)},
+	))
+
+	tests := []struct {
+		name        string
+		data        gatewayToolResourceModel
+		defaultTags []string
+		want        string
+	}{
+		{
+			name: "nothing configured",
+			data: gatewayToolResourceModel{
+				Tags:        types.ListNull(types.StringType),
+				Annotations: types.DynamicNull(),
+			},
+			want: "null",
+		},
+		{
+			name: "overrides",
+			data: gatewayToolResourceModel{
+				DisplayName: types.StringValue("Clock"),
+				Tags:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("time")}),
+				Annotations: annotations,
+			},
+			want: `{"displayName":"Clock","annotations":{"readOnlyHint":true},"tags":["time"]}`,
+		},
+		{
+			name: "empty tags and annotations",
+			data: gatewayToolResourceModel{
+				Tags:        emptyTags,
+				Annotations: emptyAnnotations,
+			},
+			want: `{"annotations":{},"tags":[]}`,
+		},
+		{
+			name: "empty tags with default tags",
+			data: gatewayToolResourceModel{
+				Tags:        emptyTags,
+				Annotations: types.DynamicNull(),
+			},
+			defaultTags: []string{"managed"},
+			want:        `{"tags":["managed"]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, diags := gatewayToolUpdateFromModel(ctx, &tt.data, tt.defaultTags)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			got, err := json.Marshal(body)
+			if err != nil {
+				t.Fatalf("failed to marshal body; %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("expected body %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
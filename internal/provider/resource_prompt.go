@@ -0,0 +1,841 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+	"github.com/leefowlercu/terraform-provider-contextforge/internal/tfconv"
+)
+
+// promptUpdateRequest is the request body for updating a prompt. The SDK's PromptUpdate
+// leaves out empty arguments and tags, so they are replaced by pointers that send a
+// configured empty list, clearing them.
+type promptUpdateRequest struct {
+	*contextforge.PromptUpdate
+	Arguments *[]contextforge.PromptArgument `json:"arguments,omitempty"`
+	Tags      *[]string                      `json:"tags,omitempty"`
+}
+
+type promptResource struct {
+	client            *contextforge.Client
+	defaultTags       []string
+	defaultTeamID     string
+	defaultVisibility string
+	readOnly          bool
+}
+
+// Force compile-time validation that promptResource satisfies the resource.Resource interface.
+var _ resource.Resource = &promptResource{}
+
+// Force compile-time validation that promptResource satisfies the resource.ResourceWithConfigure interface.
+var _ resource.ResourceWithConfigure = &promptResource{}
+
+// Force compile-time validation that promptResource satisfies the resource.ResourceWithImportState interface.
+var _ resource.ResourceWithImportState = &promptResource{}
+
+// Force compile-time validation that promptResource satisfies the resource.ResourceWithModifyPlan interface.
+var _ resource.ResourceWithModifyPlan = &promptResource{}
+
+// promptResourceModel defines the resource model.
+type promptResourceModel struct {
+	// Core fields
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Template    types.String `tfsdk:"template"`
+	Arguments   types.List   `tfsdk:"arguments"`
+	IsActive    types.Bool   `tfsdk:"is_active"`
+
+	// Nested metrics
+	Metrics types.Object `tfsdk:"metrics"`
+
+	// Organizational fields
+	Tags       types.List   `tfsdk:"tags"`
+	TagsAll    types.List   `tfsdk:"tags_all"`
+	TeamID     types.String `tfsdk:"team_id"`
+	Team       types.String `tfsdk:"team"`
+	OwnerEmail types.String `tfsdk:"owner_email"`
+	Visibility types.String `tfsdk:"visibility"`
+
+	// Timestamps
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+
+	// Metadata (read-only)
+	CreatedBy         types.String `tfsdk:"created_by"`
+	CreatedFromIP     types.String `tfsdk:"created_from_ip"`
+	CreatedVia        types.String `tfsdk:"created_via"`
+	CreatedUserAgent  types.String `tfsdk:"created_user_agent"`
+	ModifiedBy        types.String `tfsdk:"modified_by"`
+	ModifiedFromIP    types.String `tfsdk:"modified_from_ip"`
+	ModifiedVia       types.String `tfsdk:"modified_via"`
+	ModifiedUserAgent types.String `tfsdk:"modified_user_agent"`
+	ImportBatchID     types.String `tfsdk:"import_batch_id"`
+	FederationSource  types.String `tfsdk:"federation_source"`
+	Version           types.Int64  `tfsdk:"version"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewPromptResource is a helper function to instantiate the prompt resource.
+func NewPromptResource() resource.Resource {
+	return &promptResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *promptResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prompt"
+}
+
+// Schema defines the schema for the resource.
+func (r *promptResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ContextForge prompt",
+		Description:         "Manages a ContextForge prompt",
+
+		Attributes: map[string]schema.Attribute{
+			// Core fields
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Prompt unique identifier",
+				Description:         "Prompt unique identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Prompt name",
+				Description:         "Prompt name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Prompt description",
+				Description:         "Prompt description",
+				Optional:            true,
+			},
+			"template": schema.StringAttribute{
+				MarkdownDescription: "Prompt template with argument placeholders",
+				Description:         "Prompt template with argument placeholders",
+				Required:            true,
+			},
+			"arguments": schema.ListNestedAttribute{
+				MarkdownDescription: "Prompt arguments/parameters",
+				Description:         "Prompt arguments/parameters",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Argument name",
+							Description:         "Argument name",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Argument description",
+							Description:         "Argument description",
+							Optional:            true,
+						},
+						"required": schema.BoolAttribute{
+							MarkdownDescription: "Whether the argument is required (default: false)",
+							Description:         "Whether the argument is required (default: false)",
+							Optional:            true,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the prompt is active (read-only, computed by backend)",
+				Description:         "Whether the prompt is active (read-only, computed by backend)",
+				Computed:            true,
+			},
+
+			// Nested metrics
+			"metrics": schema.SingleNestedAttribute{
+				MarkdownDescription: "Prompt performance metrics (read-only)",
+				Description:         "Prompt performance metrics (read-only)",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"total_executions": schema.Int64Attribute{
+						MarkdownDescription: "Total number of executions",
+						Description:         "Total number of executions",
+						Computed:            true,
+					},
+					"successful_executions": schema.Int64Attribute{
+						MarkdownDescription: "Number of successful executions",
+						Description:         "Number of successful executions",
+						Computed:            true,
+					},
+					"failed_executions": schema.Int64Attribute{
+						MarkdownDescription: "Number of failed executions",
+						Description:         "Number of failed executions",
+						Computed:            true,
+					},
+					"failure_rate": schema.Float64Attribute{
+						MarkdownDescription: "Failure rate (0.0 to 1.0)",
+						Description:         "Failure rate (0.0 to 1.0)",
+						Computed:            true,
+					},
+					"min_response_time": schema.Float64Attribute{
+						MarkdownDescription: "Minimum response time in milliseconds",
+						Description:         "Minimum response time in milliseconds",
+						Computed:            true,
+					},
+					"max_response_time": schema.Float64Attribute{
+						MarkdownDescription: "Maximum response time in milliseconds",
+						Description:         "Maximum response time in milliseconds",
+						Computed:            true,
+					},
+					"avg_response_time": schema.Float64Attribute{
+						MarkdownDescription: "Average response time in milliseconds",
+						Description:         "Average response time in milliseconds",
+						Computed:            true,
+					},
+					"last_execution_time": schema.StringAttribute{
+						MarkdownDescription: "Last execution timestamp (RFC3339 format)",
+						Description:         "Last execution timestamp (RFC3339 format)",
+						Computed:            true,
+					},
+				},
+			},
+
+			// Organizational fields
+			"tags": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Prompt tags",
+				Description:         "Prompt tags",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags_all": tagsAllSchemaAttribute(),
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team ID",
+				Description:         "Team ID",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team": schema.StringAttribute{
+				MarkdownDescription: "Team name (read-only)",
+				Description:         "Team name (read-only)",
+				Computed:            true,
+			},
+			"owner_email": schema.StringAttribute{
+				MarkdownDescription: "Owner email address (read-only)",
+				Description:         "Owner email address (read-only)",
+				Computed:            true,
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: "Visibility setting (public, private, etc.)",
+				Description:         "Visibility setting (public, private, etc.)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Timestamps (read-only)
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp (RFC3339 format, read-only)",
+				Description:         "Creation timestamp (RFC3339 format, read-only)",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp (RFC3339 format, read-only)",
+				Description:         "Last update timestamp (RFC3339 format, read-only)",
+				Computed:            true,
+			},
+
+			// Metadata (read-only)
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "User who created the prompt (read-only metadata)",
+				Description:         "User who created the prompt (read-only metadata)",
+				Computed:            true,
+			},
+			"created_from_ip": schema.StringAttribute{
+				MarkdownDescription: "IP address of creator (read-only metadata)",
+				Description:         "IP address of creator (read-only metadata)",
+				Computed:            true,
+			},
+			"created_via": schema.StringAttribute{
+				MarkdownDescription: "Creation method (read-only metadata)",
+				Description:         "Creation method (read-only metadata)",
+				Computed:            true,
+			},
+			"created_user_agent": schema.StringAttribute{
+				MarkdownDescription: "User agent of creator (read-only metadata)",
+				Description:         "User agent of creator (read-only metadata)",
+				Computed:            true,
+			},
+			"modified_by": schema.StringAttribute{
+				MarkdownDescription: "User who last modified the prompt (read-only metadata)",
+				Description:         "User who last modified the prompt (read-only metadata)",
+				Computed:            true,
+			},
+			"modified_from_ip": schema.StringAttribute{
+				MarkdownDescription: "IP address of last modifier (read-only metadata)",
+				Description:         "IP address of last modifier (read-only metadata)",
+				Computed:            true,
+			},
+			"modified_via": schema.StringAttribute{
+				MarkdownDescription: "Modification method (read-only metadata)",
+				Description:         "Modification method (read-only metadata)",
+				Computed:            true,
+			},
+			"modified_user_agent": schema.StringAttribute{
+				MarkdownDescription: "User agent of last modifier (read-only metadata)",
+				Description:         "User agent of last modifier (read-only metadata)",
+				Computed:            true,
+			},
+			"import_batch_id": schema.StringAttribute{
+				MarkdownDescription: "Import batch identifier (read-only metadata)",
+				Description:         "Import batch identifier (read-only metadata)",
+				Computed:            true,
+			},
+			"federation_source": schema.StringAttribute{
+				MarkdownDescription: "Federation source (read-only metadata)",
+				Description:         "Federation source (read-only metadata)",
+				Computed:            true,
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "Version number (read-only metadata)",
+				Description:         "Version number (read-only metadata)",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan plans tags_all from the configured tags and the provider default tags, and
+// applies the provider default team and visibility to new prompts.
+func (r *promptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTagsAll(ctx, req, resp, r.defaultTags)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modifyPlanTeamDefaults(ctx, req, resp, r.defaultTeamID, r.defaultVisibility)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *promptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+	r.defaultTags = data.DefaultTags
+	r.defaultTeamID = data.DefaultTeamID
+	r.defaultVisibility = data.DefaultVisibility
+	r.readOnly = data.ReadOnly
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *promptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "prompt", &resp.Diagnostics) {
+		return
+	}
+
+	var data promptResourceModel
+
+	// Read plan data
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Build the prompt create request
+	promptCreate := &contextforge.PromptCreate{
+		Name:     data.Name.ValueString(),
+		Template: data.Template.ValueString(),
+	}
+
+	// Optional fields
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		desc := data.Description.ValueString()
+		promptCreate.Description = &desc
+	}
+
+	if !data.Arguments.IsNull() && !data.Arguments.IsUnknown() {
+		arguments, argsDiags := promptArgumentsFromList(ctx, data.Arguments)
+		resp.Diagnostics.Append(argsDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		promptCreate.Arguments = arguments
+	}
+
+	// Tags, merged with the provider default tags
+	tagNames, setTags, tagsDiags := requestTags(ctx, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if setTags {
+		promptCreate.Tags = tagNames
+	}
+
+	// CreateOptions for team_id and visibility
+	opts := &contextforge.PromptCreateOptions{}
+	if !data.TeamID.IsNull() && !data.TeamID.IsUnknown() {
+		teamID := data.TeamID.ValueString()
+		opts.TeamID = &teamID
+	}
+
+	if !data.Visibility.IsNull() && !data.Visibility.IsUnknown() {
+		visibility := data.Visibility.ValueString()
+		opts.Visibility = &visibility
+	}
+
+	// Create the prompt
+	createdPrompt, _, err := r.client.Prompts.Create(ctx, promptCreate, opts)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create Prompt",
+			fmt.Sprintf("Unable to create prompt; %v", err),
+		)
+		return
+	}
+
+	// Map response to state
+	r.mapPromptToState(ctx, createdPrompt, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *promptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data promptResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	prompt, err := findPrompt(ctx, r.client, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to List Prompts",
+			fmt.Sprintf("Unable to list prompts; %v", err),
+		)
+		return
+	}
+
+	// Prompt no longer exists
+	if prompt == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Map response to state
+	r.mapPromptToState(ctx, prompt, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *promptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.readOnly, "update", "prompt", &resp.Diagnostics) {
+		return
+	}
+
+	var data promptResourceModel
+
+	// Read plan data
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Build the prompt update request using three-state semantics
+	name := data.Name.ValueString()
+	template := data.Template.ValueString()
+	promptUpdate := &contextforge.PromptUpdate{
+		Name:     &name,
+		Template: &template,
+	}
+
+	if !data.Description.IsUnknown() {
+		if data.Description.IsNull() {
+			emptyStr := ""
+			promptUpdate.Description = &emptyStr // Explicitly clear
+		} else {
+			desc := data.Description.ValueString()
+			promptUpdate.Description = &desc
+		}
+	}
+
+	if !data.Arguments.IsUnknown() {
+		if data.Arguments.IsNull() {
+			promptUpdate.Arguments = []contextforge.PromptArgument{} // Clear arguments
+		} else {
+			arguments, argsDiags := promptArgumentsFromList(ctx, data.Arguments)
+			resp.Diagnostics.Append(argsDiags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			promptUpdate.Arguments = arguments
+		}
+	}
+
+	// Tags, merged with the provider default tags
+	tagNames, setTags, tagsDiags := requestTags(ctx, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if setTags {
+		promptUpdate.Tags = tagNames
+	} else if data.Tags.IsNull() {
+		promptUpdate.Tags = []string{} // Clear tags
+	}
+
+	if !data.TeamID.IsNull() && !data.TeamID.IsUnknown() {
+		teamID := data.TeamID.ValueString()
+		promptUpdate.TeamID = &teamID
+	}
+
+	if !data.Visibility.IsNull() && !data.Visibility.IsUnknown() {
+		visibility := data.Visibility.ValueString()
+		promptUpdate.Visibility = &visibility
+	}
+
+	// Get the prompt ID
+	promptID := data.ID.ValueString()
+
+	body := &promptUpdateRequest{PromptUpdate: promptUpdate}
+	if promptUpdate.Arguments != nil {
+		body.Arguments = &promptUpdate.Arguments
+	}
+	if promptUpdate.Tags != nil {
+		body.Tags = &promptUpdate.Tags
+	}
+
+	// Update the prompt
+	httpReq, err := r.client.NewRequest(http.MethodPut, "prompts/"+url.PathEscape(promptID), body)
+	if err == nil {
+		_, err = r.client.Do(ctx, httpReq, nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update Prompt",
+			fmt.Sprintf("Unable to update prompt with ID %s; %v", promptID, err),
+		)
+		return
+	}
+
+	// Read the updated prompt via List and filter to get complete state
+	updatedPrompt, err := findPrompt(ctx, r.client, promptID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Prompt After Update",
+			fmt.Sprintf("Unable to read prompt after update; %v", err),
+		)
+		return
+	}
+
+	if updatedPrompt == nil {
+		resp.Diagnostics.AddError(
+			"Prompt Not Found After Update",
+			fmt.Sprintf("Unable to find prompt with ID %s after update", promptID),
+		)
+		return
+	}
+
+	// Map response to state
+	r.mapPromptToState(ctx, updatedPrompt, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *promptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.readOnly, "delete", "prompt", &resp.Diagnostics) {
+		return
+	}
+
+	var data promptResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete the prompt
+	httpResp, err := r.client.Prompts.Delete(ctx, data.ID.ValueString())
+	if err != nil {
+		// Ignore 404 errors (prompt already deleted)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Delete Prompt",
+			fmt.Sprintf("Unable to delete prompt with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+}
+
+// ImportState imports the prompt into Terraform state.
+func (r *promptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import by ID
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// findPrompt looks up a prompt by ID. The API has no metadata Get endpoint for prompts
+// (POST /prompts/{id} renders the template), so every page of List is searched by ID.
+// Returns nil without an error when the prompt does not exist.
+func findPrompt(ctx context.Context, client *contextforge.Client, promptID string) (*contextforge.Prompt, error) {
+	opts := &contextforge.PromptListOptions{IncludeInactive: true}
+	for {
+		prompts, resp, err := client.Prompts.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range prompts {
+			if p.ID == promptID {
+				return p, nil
+			}
+		}
+
+		if resp.NextCursor == "" {
+			return nil, nil
+		}
+		opts.Cursor = resp.NextCursor
+	}
+}
+
+// promptArgumentsFromList converts the arguments attribute to SDK prompt arguments.
+func promptArgumentsFromList(ctx context.Context, list types.List) ([]contextforge.PromptArgument, diag.Diagnostics) {
+	var argModels []promptArgumentModel
+	diags := list.ElementsAs(ctx, &argModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	arguments := make([]contextforge.PromptArgument, len(argModels))
+	for i, arg := range argModels {
+		arguments[i] = contextforge.PromptArgument{
+			Name:        arg.Name.ValueString(),
+			Description: arg.Description.ValueStringPointer(),
+			Required:    arg.Required.ValueBool(),
+		}
+	}
+
+	return arguments, diags
+}
+
+// mapPromptToState maps SDK Prompt to Terraform state model.
+func (r *promptResource) mapPromptToState(ctx context.Context, prompt *contextforge.Prompt, data *promptResourceModel, diags *diag.Diagnostics) {
+	// Map core fields
+	data.ID = types.StringValue(prompt.ID)
+	data.Name = types.StringValue(prompt.Name)
+	data.Template = types.StringValue(prompt.Template)
+	data.IsActive = types.BoolValue(prompt.IsActive)
+
+	// A description cleared by Update is returned as an empty string; keep it null
+	if prompt.Description == nil || (*prompt.Description == "" && data.Description.IsNull()) {
+		data.Description = types.StringNull()
+	} else {
+		data.Description = types.StringValue(*prompt.Description)
+	}
+
+	// Map arguments; an empty list is kept when the arguments were set to an empty list
+	argType := types.ObjectType{AttrTypes: promptArgumentModel{}.attrTypes()}
+	if len(prompt.Arguments) > 0 {
+		argModels := make([]promptArgumentModel, len(prompt.Arguments))
+		for i, arg := range prompt.Arguments {
+			argModels[i] = promptArgumentModel{
+				Name:        types.StringValue(arg.Name),
+				Description: types.StringPointerValue(arg.Description),
+				Required:    types.BoolValue(arg.Required),
+			}
+		}
+		argsList, argsDiags := types.ListValueFrom(ctx, argType, argModels)
+		diags.Append(argsDiags...)
+		if diags.HasError() {
+			return
+		}
+		data.Arguments = argsList
+	} else if !data.Arguments.IsNull() && !data.Arguments.IsUnknown() {
+		data.Arguments = types.ListValueMust(argType, []attr.Value{})
+	} else {
+		data.Arguments = types.ListNull(argType)
+	}
+
+	// Map nested metrics
+	if prompt.Metrics != nil {
+		metricsModel := promptMetricsModel{
+			TotalExecutions:      types.Int64Value(int64(prompt.Metrics.TotalExecutions)),
+			SuccessfulExecutions: types.Int64Value(int64(prompt.Metrics.SuccessfulExecutions)),
+			FailedExecutions:     types.Int64Value(int64(prompt.Metrics.FailedExecutions)),
+			FailureRate:          types.Float64Value(prompt.Metrics.FailureRate),
+		}
+
+		// Handle optional float64 pointers
+		if prompt.Metrics.MinResponseTime != nil {
+			metricsModel.MinResponseTime = types.Float64Value(*prompt.Metrics.MinResponseTime)
+		} else {
+			metricsModel.MinResponseTime = types.Float64Null()
+		}
+
+		if prompt.Metrics.MaxResponseTime != nil {
+			metricsModel.MaxResponseTime = types.Float64Value(*prompt.Metrics.MaxResponseTime)
+		} else {
+			metricsModel.MaxResponseTime = types.Float64Null()
+		}
+
+		if prompt.Metrics.AvgResponseTime != nil {
+			metricsModel.AvgResponseTime = types.Float64Value(*prompt.Metrics.AvgResponseTime)
+		} else {
+			metricsModel.AvgResponseTime = types.Float64Null()
+		}
+
+		// Handle optional timestamp
+		if prompt.Metrics.LastExecutionTime != nil && !prompt.Metrics.LastExecutionTime.Time.IsZero() {
+			metricsModel.LastExecutionTime = types.StringValue(prompt.Metrics.LastExecutionTime.Time.Format(time.RFC3339))
+		} else {
+			metricsModel.LastExecutionTime = types.StringNull()
+		}
+
+		// Convert metrics model to object
+		metricsObject, metricsDiags := types.ObjectValueFrom(ctx, metricsModel.attrTypes(), metricsModel)
+		diags.Append(metricsDiags...)
+		if diags.HasError() {
+			return
+		}
+		data.Metrics = metricsObject
+	} else {
+		data.Metrics = types.ObjectNull(promptMetricsModel{}.attrTypes())
+	}
+
+	// Map organizational fields
+	tags, tagsAll, tagsDiags := stateTags(ctx, prompt.Tags, data.Tags, r.defaultTags)
+	diags.Append(tagsDiags...)
+	data.Tags = tags
+	data.TagsAll = tagsAll
+
+	data.TeamID = types.StringPointerValue(prompt.TeamID)
+	data.Team = types.StringPointerValue(prompt.Team)
+	data.OwnerEmail = types.StringPointerValue(prompt.OwnerEmail)
+	data.Visibility = types.StringPointerValue(prompt.Visibility)
+
+	// Map timestamps (convert to RFC3339 string)
+	if prompt.CreatedAt != nil && !prompt.CreatedAt.Time.IsZero() {
+		data.CreatedAt = types.StringValue(prompt.CreatedAt.Time.Format(time.RFC3339))
+	} else {
+		data.CreatedAt = types.StringNull()
+	}
+
+	if prompt.UpdatedAt != nil && !prompt.UpdatedAt.Time.IsZero() {
+		data.UpdatedAt = types.StringValue(prompt.UpdatedAt.Time.Format(time.RFC3339))
+	} else {
+		data.UpdatedAt = types.StringNull()
+	}
+
+	// Map metadata fields
+	data.CreatedBy = types.StringPointerValue(prompt.CreatedBy)
+	data.CreatedFromIP = types.StringPointerValue(prompt.CreatedFromIP)
+	data.CreatedVia = types.StringPointerValue(prompt.CreatedVia)
+	data.CreatedUserAgent = types.StringPointerValue(prompt.CreatedUserAgent)
+	data.ModifiedBy = types.StringPointerValue(prompt.ModifiedBy)
+	data.ModifiedFromIP = types.StringPointerValue(prompt.ModifiedFromIP)
+	data.ModifiedVia = types.StringPointerValue(prompt.ModifiedVia)
+	data.ModifiedUserAgent = types.StringPointerValue(prompt.ModifiedUserAgent)
+	data.ImportBatchID = types.StringPointerValue(prompt.ImportBatchID)
+	data.FederationSource = types.StringPointerValue(prompt.FederationSource)
+
+	// Convert *int to types.Int64 for version
+	if prompt.Version != nil {
+		data.Version = types.Int64PointerValue(tfconv.Int64Ptr(*prompt.Version))
+	} else {
+		data.Version = types.Int64Null()
+	}
+}
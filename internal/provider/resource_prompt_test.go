@@ -0,0 +1,280 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+// TestAccPromptResource_basic tests the basic CRUD lifecycle for a prompt resource.
+// This test verifies:
+//   - Create with minimal required fields (name, template)
+//   - Read to verify created values
+//   - Update to modify fields
+//   - Removing the description clears it
+//   - Delete to remove resource
+//
+// Prerequisites:
+//   - CONTEXTFORGE_ADDR environment variable set
+//   - CONTEXTFORGE_TOKEN environment variable set
+//
+// To run:
+//   make integration-test-all  # Full lifecycle with setup/teardown
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccPromptResource_basic
+func TestAccPromptResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPromptResourceConfig("tf-test-prompt", "Test prompt created by Terraform"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify computed attributes
+					resource.TestCheckResourceAttrSet("contextforge_prompt.test", "id"),
+					resource.TestCheckResourceAttrSet("contextforge_prompt.test", "created_at"),
+					resource.TestCheckResourceAttrSet("contextforge_prompt.test", "is_active"),
+
+					// Verify configured attributes
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "name", "tf-test-prompt"),
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "description", "Test prompt created by Terraform"),
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "template", "Summarize the following text: {{ text }}"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccPromptResourceConfig("tf-test-prompt-updated", "Updated prompt description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("contextforge_prompt.test", "id"),
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "name", "tf-test-prompt-updated"),
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "description", "Updated prompt description"),
+				),
+			},
+			// Removing the description clears it
+			{
+				Config: `
+resource "contextforge_prompt" "test" {
+  name     = "tf-test-prompt-updated"
+  template = "Summarize the following text: {{ text }}"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("contextforge_prompt.test", "description"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:      "contextforge_prompt.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccPromptResource_arguments tests creating and updating prompt arguments and tags.
+// This verifies that the nested arguments list round-trips through the API, that
+// argument changes are applied without forcing resource recreation, and that an empty
+// arguments list clears them.
+//
+// To run:
+//   make integration-test-all
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccPromptResource_arguments
+func TestAccPromptResource_arguments(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with a single required argument
+			{
+				Config: testAccPromptResourceConfigWithArguments(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "arguments.#", "1"),
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "arguments.0.name", "language"),
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "arguments.0.required", "true"),
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "tags.#", "1"),
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "tags.0", "terraform"),
+				),
+			},
+			// Add an optional argument and a tag
+			{
+				Config: testAccPromptResourceConfigWithArguments(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "arguments.#", "2"),
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "arguments.1.name", "tone"),
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "arguments.1.description", "Tone of the translation"),
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "arguments.1.required", "false"),
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "tags.1", "testing"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:      "contextforge_prompt.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Clear the arguments with an empty list
+			{
+				Config: `
+resource "contextforge_prompt" "test" {
+  name      = "tf-test-prompt-arguments"
+  template  = "Translate the following text."
+  arguments = []
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("contextforge_prompt.test", "arguments.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccPromptResource_missingRequired tests error handling when required fields are missing.
+// This verifies that the resource properly validates required attributes.
+//
+// To run:
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccPromptResource_missingRequired
+func TestAccPromptResource_missingRequired(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPromptResourceConfigMissingTemplate(),
+				ExpectError: regexp.MustCompile(`Missing required argument|The argument "template" is required`),
+			},
+		},
+	})
+}
+
+// testAccPromptResourceConfig generates basic Terraform configuration for a prompt resource.
+// This helper creates a minimal valid prompt configuration.
+//
+// Parameters:
+//   - name: Prompt name
+//   - description: Prompt description
+//
+// Returns:
+//   - HCL configuration string
+func testAccPromptResourceConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "contextforge_prompt" "test" {
+  name        = %[1]q
+  description = %[2]q
+  template    = "Summarize the following text: {{ text }}"
+
+  arguments = [
+    {
+      name        = "text"
+      description = "Text to summarize"
+      required    = true
+    },
+  ]
+}
+`, name, description)
+}
+
+// testAccPromptResourceConfigWithArguments generates Terraform configuration with arguments
+// and tags. When extended is true, a second optional argument and a second tag are added.
+//
+// Returns:
+//   - HCL configuration string with arguments and tags
+func testAccPromptResourceConfigWithArguments(extended bool) string {
+	arguments := `
+    {
+      name     = "language"
+      required = true
+    },`
+	tags := `["terraform"]`
+
+	if extended {
+		arguments += `
+    {
+      name        = "tone"
+      description = "Tone of the translation"
+      required    = false
+    },`
+		tags = `["terraform", "testing"]`
+	}
+
+	return fmt.Sprintf(`
+resource "contextforge_prompt" "test" {
+  name     = "tf-test-prompt-arguments"
+  template = "Translate the following text into {{ language }}."
+  tags     = %[2]s
+
+  arguments = [%[1]s
+  ]
+}
+`, arguments, tags)
+}
+
+// testAccPromptResourceConfigMissingTemplate generates invalid Terraform configuration
+// with missing required template attribute. This is used to test error handling.
+//
+// Returns:
+//   - HCL configuration string missing the required template attribute
+func testAccPromptResourceConfigMissingTemplate() string {
+	return `
+resource "contextforge_prompt" "test" {
+  name        = "test-prompt"
+  description = "Prompt missing required template"
+}
+`
+}
+
+// TestFindPrompt verifies that findPrompt follows the list cursor to later pages.
+//
+// To run:
+//
+//	go test -v ./internal/provider/ -run TestFindPrompt
+func TestFindPrompt(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/prompts" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			w.Header().Set("X-Next-Cursor", "page-2")
+			_, _ = w.Write([]byte(`[{"id":"prompt-1","name":"first","template":"one"}]`))
+		case "page-2":
+			_, _ = w.Write([]byte(`[{"id":"prompt-2","name":"second","template":"two"}]`))
+		default:
+			http.Error(w, "unexpected cursor", http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	client, err := contextforge.NewClient(server.Client(), server.URL, "")
+	if err != nil {
+		t.Fatalf("failed to create client; %v", err)
+	}
+
+	prompt, err := findPrompt(ctx, client, "prompt-2")
+	if err != nil {
+		t.Fatalf("unexpected error; %v", err)
+	}
+	if prompt == nil || prompt.Name != "second" {
+		t.Errorf("expected prompt-2 from the second page, got %+v", prompt)
+	}
+
+	prompt, err = findPrompt(ctx, client, "prompt-3")
+	if err != nil {
+		t.Fatalf("unexpected error; %v", err)
+	}
+	if prompt != nil {
+		t.Errorf("expected no prompt, got %+v", prompt)
+	}
+}
@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -18,7 +20,12 @@ import (
 )
 
 type resourceResource struct {
-	client *contextforge.Client
+	client            *contextforge.Client
+	defaultTags       []string
+	defaultTeamID     string
+	defaultVisibility string
+	readOnly          bool
+	features          gatewayFeatures
 }
 
 // Force compile-time validation that resourceResource satisfies the resource.Resource interface.
@@ -30,6 +37,9 @@ var _ resource.ResourceWithConfigure = &resourceResource{}
 // Force compile-time validation that resourceResource satisfies the resource.ResourceWithImportState interface.
 var _ resource.ResourceWithImportState = &resourceResource{}
 
+// Force compile-time validation that resourceResource satisfies the resource.ResourceWithModifyPlan interface.
+var _ resource.ResourceWithModifyPlan = &resourceResource{}
+
 // resourceResourceModel defines the resource model.
 type resourceResourceModel struct {
 	// Core fields
@@ -47,6 +57,7 @@ type resourceResourceModel struct {
 
 	// Organizational fields
 	Tags       types.List   `tfsdk:"tags"`
+	TagsAll    types.List   `tfsdk:"tags_all"`
 	TeamID     types.String `tfsdk:"team_id"`
 	Team       types.String `tfsdk:"team"`
 	OwnerEmail types.String `tfsdk:"owner_email"`
@@ -68,6 +79,8 @@ type resourceResourceModel struct {
 	ImportBatchID     types.String `tfsdk:"import_batch_id"`
 	FederationSource  types.String `tfsdk:"federation_source"`
 	Version           types.Int64  `tfsdk:"version"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewResourceResource is a helper function to instantiate the resource resource.
@@ -204,6 +217,7 @@ func (r *resourceResource) Schema(ctx context.Context, req resource.SchemaReques
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"tags_all": tagsAllSchemaAttribute(),
 			"team_id": schema.StringAttribute{
 				MarkdownDescription: "Team ID (can only be set at creation)",
 				Description:         "Team ID (can only be set at creation)",
@@ -304,29 +318,57 @@ func (r *resourceResource) Schema(ctx context.Context, req resource.SchemaReques
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
+// ModifyPlan plans tags_all from the configured tags and the provider default tags, and
+// applies the provider default team and visibility to new resources.
+func (r *resourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTagsAll(ctx, req, resp, r.defaultTags)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	modifyPlanTeamDefaults(ctx, req, resp, r.defaultTeamID, r.defaultVisibility)
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *resourceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*contextforge.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *contextforge.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaultTags = data.DefaultTags
+	r.defaultTeamID = data.DefaultTeamID
+	r.defaultVisibility = data.DefaultVisibility
+	r.readOnly = data.ReadOnly
+	r.features = data.Features
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *resourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "resource", &resp.Diagnostics) {
+		return
+	}
+
 	var data resourceResourceModel
 
 	// Read plan data
@@ -335,6 +377,15 @@ func (r *resourceResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Build the resource create request
 	resourceCreate := &contextforge.ResourceCreate{
 		URI:     data.URI.ValueString(),
@@ -356,15 +407,14 @@ func (r *resourceResource) Create(ctx context.Context, req resource.CreateReques
 	// Note: Size and IsActive are not supported by ResourceCreate API
 	// They are computed fields managed by the backend
 
-	// Tags
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		var tags []string
-		diags := data.Tags.ElementsAs(ctx, &tags, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		resourceCreate.Tags = tags
+	// Tags, merged with the provider default tags
+	tagNames, setTags, tagsDiags := requestTags(ctx, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if setTags {
+		resourceCreate.Tags = tagNames
 	}
 
 	// CreateOptions for team_id and visibility
@@ -409,6 +459,15 @@ func (r *resourceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get resource from API using List and filter
 	// Note: The API doesn't have a dedicated Get endpoint for resources by ID,
 	// so we must use List() and filter by ID
@@ -452,6 +511,10 @@ func (r *resourceResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *resourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.readOnly, "update", "resource", &resp.Diagnostics) {
+		return
+	}
+
 	var data resourceResourceModel
 
 	// Read plan data
@@ -460,6 +523,15 @@ func (r *resourceResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Build the resource update request using three-state semantics
 	resourceUpdate := &contextforge.ResourceUpdate{}
 
@@ -499,18 +571,26 @@ func (r *resourceResource) Update(ctx context.Context, req resource.UpdateReques
 	// Note: Size and IsActive are not supported by ResourceUpdate API
 	// They are computed fields managed by the backend
 
-	// Tags
-	if !data.Tags.IsUnknown() {
-		if data.Tags.IsNull() {
-			resourceUpdate.Tags = []string{} // Clear tags
-		} else {
-			var tags []string
-			diags := data.Tags.ElementsAs(ctx, &tags, false)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			resourceUpdate.Tags = tags
+	// Tags, merged with the provider default tags
+	tagNames, setTags, tagsDiags := requestTags(ctx, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if setTags {
+		resourceUpdate.Tags = tagNames
+	} else if data.Tags.IsNull() {
+		resourceUpdate.Tags = []string{} // Clear tags
+	}
+
+	// An empty tag list is left out of the update request, so the tags are cleared first
+	if setTags && len(tagNames) == 0 {
+		if err := clearTags(ctx, r.client, "resources/"+url.PathEscape(data.ID.ValueString())); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Clear Tags",
+				fmt.Sprintf("Unable to clear tags of resource with ID %s; %v", data.ID.ValueString(), err),
+			)
+			return
 		}
 	}
 
@@ -518,7 +598,7 @@ func (r *resourceResource) Update(ctx context.Context, req resource.UpdateReques
 	resourceID := data.ID.ValueString()
 
 	// Update the resource
-	_, _, err := r.client.Resources.Update(ctx, resourceID, resourceUpdate)
+	updatedResource, _, err := r.client.Resources.Update(ctx, resourceID, resourceUpdate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to Update Resource",
@@ -527,32 +607,34 @@ func (r *resourceResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	// The Update API response doesn't include all fields (e.g., team_id is null).
-	// Workaround: Do a fresh GET via List and filter to get complete state.
-	resources, _, err := r.client.Resources.List(ctx, nil)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Failed to Read Resource After Update",
-			fmt.Sprintf("Unable to read resource after update; %v", err),
-		)
-		return
-	}
+	// On gateways whose Update API response doesn't include all fields (e.g., team_id is null),
+	// do a fresh GET via List and filter to get complete state (see capabilityResourceUpdateResponse).
+	if !r.features.supports(capabilityResourceUpdateResponse) {
+		resources, _, err := r.client.Resources.List(ctx, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Read Resource After Update",
+				fmt.Sprintf("Unable to read resource after update; %v", err),
+			)
+			return
+		}
 
-	// Find the updated resource by ID
-	var updatedResource *contextforge.Resource
-	for _, res := range resources {
-		if res.ID != nil && res.ID.String() == resourceID {
-			updatedResource = res
-			break
+		// Find the updated resource by ID
+		updatedResource = nil
+		for _, res := range resources {
+			if res.ID != nil && res.ID.String() == resourceID {
+				updatedResource = res
+				break
+			}
 		}
-	}
 
-	if updatedResource == nil {
-		resp.Diagnostics.AddError(
-			"Resource Not Found After Update",
-			fmt.Sprintf("Unable to find resource with ID %s after update", resourceID),
-		)
-		return
+		if updatedResource == nil {
+			resp.Diagnostics.AddError(
+				"Resource Not Found After Update",
+				fmt.Sprintf("Unable to find resource with ID %s after update", resourceID),
+			)
+			return
+		}
 	}
 
 	// Map response to state
@@ -567,6 +649,10 @@ func (r *resourceResource) Update(ctx context.Context, req resource.UpdateReques
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *resourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.readOnly, "delete", "resource", &resp.Diagnostics) {
+		return
+	}
+
 	var data resourceResourceModel
 
 	// Read current state
@@ -575,6 +661,15 @@ func (r *resourceResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the resource
 	resourceID := data.ID.ValueString()
 	_, err := r.client.Resources.Delete(ctx, resourceID)
@@ -662,13 +757,10 @@ func (r *resourceResource) mapResourceToState(ctx context.Context, resource *con
 	}
 
 	// Map organizational fields
-	if resource.Tags != nil {
-		tagsList, tagsDiags := types.ListValueFrom(ctx, types.StringType, contextforge.TagNames(resource.Tags))
-		diags.Append(tagsDiags...)
-		data.Tags = tagsList
-	} else {
-		data.Tags = types.ListNull(types.StringType)
-	}
+	tags, tagsAll, tagsDiags := stateTags(ctx, resource.Tags, data.Tags, r.defaultTags)
+	diags.Append(tagsDiags...)
+	data.Tags = tags
+	data.TagsAll = tagsAll
 
 	data.TeamID = types.StringPointerValue(resource.TeamID)
 	data.Team = types.StringPointerValue(resource.Team)
@@ -0,0 +1,548 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+// rbacScopes are the scopes a role can be defined and assigned in.
+var rbacScopes = []string{"global", "team", "personal"}
+
+// rbacRole is a role as returned by the /rbac/roles endpoints, which the SDK does not cover.
+type rbacRole struct {
+	ID                   string                  `json:"id"`
+	Name                 string                  `json:"name"`
+	Description          *string                 `json:"description"`
+	Scope                string                  `json:"scope"`
+	Permissions          []string                `json:"permissions"`
+	EffectivePermissions []string                `json:"effective_permissions"`
+	InheritsFrom         *string                 `json:"inherits_from"`
+	CreatedBy            string                  `json:"created_by"`
+	IsSystemRole         bool                    `json:"is_system_role"`
+	IsActive             bool                    `json:"is_active"`
+	CreatedAt            *contextforge.Timestamp `json:"created_at"`
+	UpdatedAt            *contextforge.Timestamp `json:"updated_at"`
+}
+
+// rbacRoleRequest is the request body for creating (POST) and updating (PUT) a role.
+// The scope can only be set on creation.
+type rbacRoleRequest struct {
+	Name         string   `json:"name"`
+	Description  *string  `json:"description,omitempty"`
+	Scope        string   `json:"scope,omitempty"`
+	Permissions  []string `json:"permissions"`
+	InheritsFrom *string  `json:"inherits_from,omitempty"`
+}
+
+type roleResource struct {
+	client   *contextforge.Client
+	readOnly bool
+}
+
+// Force compile-time validation that roleResource satisfies the resource.Resource interface.
+var _ resource.Resource = &roleResource{}
+
+// Force compile-time validation that roleResource satisfies the resource.ResourceWithConfigure interface.
+var _ resource.ResourceWithConfigure = &roleResource{}
+
+// Force compile-time validation that roleResource satisfies the resource.ResourceWithImportState interface.
+var _ resource.ResourceWithImportState = &roleResource{}
+
+// roleResourceModel defines the resource model.
+type roleResourceModel struct {
+	// Computed field
+	ID types.String `tfsdk:"id"`
+
+	// Core fields
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Scope        types.String `tfsdk:"scope"`
+	Permissions  types.Set    `tfsdk:"permissions"`
+	InheritsFrom types.String `tfsdk:"inherits_from"`
+
+	// Computed fields
+	EffectivePermissions types.Set    `tfsdk:"effective_permissions"`
+	IsSystemRole         types.Bool   `tfsdk:"is_system_role"`
+	IsActive             types.Bool   `tfsdk:"is_active"`
+	CreatedBy            types.String `tfsdk:"created_by"`
+
+	// Timestamps
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewRoleResource is a helper function to instantiate the role resource.
+func NewRoleResource() resource.Resource {
+	return &roleResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *roleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+// Schema defines the schema for the resource.
+func (r *roleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ContextForge RBAC role",
+		Description:         "Manages a ContextForge RBAC role",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed field
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Role unique identifier",
+				Description:         "Role unique identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Core fields
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Role name (unique within its scope)",
+				Description:         "Role name (unique within its scope)",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Role description",
+				Description:         "Role description",
+				Optional:            true,
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Scope the role applies in (global, team or personal; changing it replaces the role)",
+				Description:         "Scope the role applies in (global, team or personal; changing it replaces the role)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: "Permissions granted by the role (for example tools.read or `*` for all)",
+				Description:         "Permissions granted by the role (for example tools.read or * for all)",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"inherits_from": schema.StringAttribute{
+				MarkdownDescription: "ID of a parent role whose permissions are inherited (removing it replaces the role)",
+				Description:         "ID of a parent role whose permissions are inherited (removing it replaces the role)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					// The API cannot clear the parent role of an existing role
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.StateValue.IsNull() && req.PlanValue.IsNull()
+						},
+						"Removing the parent role replaces the role.",
+						"Removing the parent role replaces the role.",
+					),
+				},
+			},
+
+			// Computed fields
+			"effective_permissions": schema.SetAttribute{
+				MarkdownDescription: "All permissions of the role, including inherited ones",
+				Description:         "All permissions of the role, including inherited ones",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"is_system_role": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is a built-in system role",
+				Description:         "Whether this is a built-in system role",
+				Computed:            true,
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the role is active",
+				Description:         "Whether the role is active",
+				Computed:            true,
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user who created the role",
+				Description:         "Email address of the user who created the role",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Timestamps
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp (RFC3339 format)",
+				Description:         "Creation timestamp (RFC3339 format)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp (RFC3339 format)",
+				Description:         "Last update timestamp (RFC3339 format)",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *roleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "role", &resp.Diagnostics) {
+		return
+	}
+
+	var data roleResourceModel
+
+	// Read plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !validRBACScope(path.Root("scope"), data.Scope, &resp.Diagnostics) {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	body, diags := roleRequestFromPlan(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	body.Scope = data.Scope.ValueString()
+
+	// Create the role
+	role, _, err := r.sendRole(ctx, http.MethodPost, "rbac/roles", body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create Role",
+			fmt.Sprintf("Unable to create role %s; %v", data.Name.ValueString(), err),
+		)
+		return
+	}
+
+	// Map response to state
+	resp.Diagnostics.Append(mapRoleToState(ctx, role, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *roleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data roleResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	role, httpResp, err := r.sendRole(ctx, http.MethodGet, "rbac/roles/"+url.PathEscape(data.ID.ValueString()), nil)
+	if err != nil {
+		// Handle 404 - resource no longer exists
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Read Role",
+			fmt.Sprintf("Unable to read role with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// Deleted roles are deactivated rather than removed
+	if !role.IsActive {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Map response to state
+	resp.Diagnostics.Append(mapRoleToState(ctx, role, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *roleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.readOnly, "update", "role", &resp.Diagnostics) {
+		return
+	}
+
+	var data roleResourceModel
+
+	// Read plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	body, diags := roleRequestFromPlan(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Clear the description when it is removed from the configuration
+	if body.Description == nil {
+		body.Description = new(string)
+	}
+
+	// Update the role
+	role, _, err := r.sendRole(ctx, http.MethodPut, "rbac/roles/"+url.PathEscape(data.ID.ValueString()), body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update Role",
+			fmt.Sprintf("Unable to update role with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// Map response to state
+	resp.Diagnostics.Append(mapRoleToState(ctx, role, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *roleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.readOnly, "delete", "role", &resp.Diagnostics) {
+		return
+	}
+
+	var data roleResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete the role
+	httpReq, err := r.client.NewRequest(http.MethodDelete, "rbac/roles/"+url.PathEscape(data.ID.ValueString()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Delete Role",
+			fmt.Sprintf("Unable to delete role with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	httpResp, err := r.client.Do(ctx, httpReq, nil)
+	if err != nil {
+		// Ignore 404 errors (resource already deleted)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Delete Role",
+			fmt.Sprintf("Unable to delete role with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *roleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+// ImportState imports an existing role by ID.
+func (r *roleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// sendRole sends a request to a role endpoint and decodes the returned role.
+func (r *roleResource) sendRole(ctx context.Context, method, urlStr string, body *rbacRoleRequest) (*rbacRole, *contextforge.Response, error) {
+	var payload any
+	if body != nil {
+		payload = body
+	}
+
+	req, err := r.client.NewRequest(method, urlStr, payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var role rbacRole
+	resp, err := r.client.Do(ctx, req, &role)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &role, resp, nil
+}
+
+// roleRequestFromPlan builds the fields of a role request that can be set on both
+// creation and update.
+func roleRequestFromPlan(ctx context.Context, data *roleResourceModel) (*rbacRoleRequest, diag.Diagnostics) {
+	body := &rbacRoleRequest{
+		Name:        data.Name.ValueString(),
+		Permissions: []string{},
+	}
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		body.Description = data.Description.ValueStringPointer()
+	}
+	if !data.InheritsFrom.IsNull() && !data.InheritsFrom.IsUnknown() {
+		body.InheritsFrom = data.InheritsFrom.ValueStringPointer()
+	}
+
+	diags := data.Permissions.ElementsAs(ctx, &body.Permissions, false)
+
+	return body, diags
+}
+
+// validRBACScope reports whether scope is one of rbacScopes, adding an attribute error
+// otherwise.
+func validRBACScope(attrPath path.Path, scope types.String, diags *diag.Diagnostics) bool {
+	if scope.IsUnknown() || slices.Contains(rbacScopes, scope.ValueString()) {
+		return true
+	}
+
+	diags.AddAttributeError(
+		attrPath,
+		"Invalid Scope",
+		fmt.Sprintf("The scope must be one of %s, got %q.", strings.Join(rbacScopes, ", "), scope.ValueString()),
+	)
+	return false
+}
+
+// mapRoleToState maps an API role to the Terraform state model.
+func mapRoleToState(ctx context.Context, role *rbacRole, data *roleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ID = types.StringValue(role.ID)
+	data.Name = types.StringValue(role.Name)
+	data.Scope = types.StringValue(role.Scope)
+
+	if role.Description != nil && *role.Description != "" {
+		data.Description = types.StringPointerValue(role.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	data.InheritsFrom = types.StringPointerValue(role.InheritsFrom)
+
+	permissions, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(role.Permissions))
+	diags.Append(d...)
+	data.Permissions = permissions
+
+	effective := role.EffectivePermissions
+	if effective == nil {
+		effective = role.Permissions
+	}
+	effectivePermissions, d := types.SetValueFrom(ctx, types.StringType, nonNilStrings(effective))
+	diags.Append(d...)
+	data.EffectivePermissions = effectivePermissions
+
+	data.IsSystemRole = types.BoolValue(role.IsSystemRole)
+	data.IsActive = types.BoolValue(role.IsActive)
+	data.CreatedBy = types.StringValue(role.CreatedBy)
+
+	if role.CreatedAt != nil && !role.CreatedAt.Time.IsZero() {
+		data.CreatedAt = types.StringValue(role.CreatedAt.Time.Format(time.RFC3339))
+	} else {
+		data.CreatedAt = types.StringNull()
+	}
+
+	if role.UpdatedAt != nil && !role.UpdatedAt.Time.IsZero() {
+		data.UpdatedAt = types.StringValue(role.UpdatedAt.Time.Format(time.RFC3339))
+	} else {
+		data.UpdatedAt = types.StringNull()
+	}
+
+	return diags
+}
+
+// nonNilStrings returns values, or an empty slice when values is nil, so that it converts
+// to an empty rather than a null collection.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,464 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+// rbacUserRole is a role assignment as returned by the /rbac/users/{email}/roles
+// endpoints, which the SDK does not cover.
+type rbacUserRole struct {
+	ID        string                  `json:"id"`
+	UserEmail string                  `json:"user_email"`
+	RoleID    string                  `json:"role_id"`
+	RoleName  *string                 `json:"role_name"`
+	Scope     string                  `json:"scope"`
+	ScopeID   *string                 `json:"scope_id"`
+	GrantedBy string                  `json:"granted_by"`
+	GrantedAt *contextforge.Timestamp `json:"granted_at"`
+	IsActive  bool                    `json:"is_active"`
+}
+
+// rbacUserRoleRequest is the request body for assigning a role to a user.
+type rbacUserRoleRequest struct {
+	RoleID  string  `json:"role_id"`
+	Scope   string  `json:"scope"`
+	ScopeID *string `json:"scope_id,omitempty"`
+}
+
+type roleAssignmentResource struct {
+	client   *contextforge.Client
+	readOnly bool
+}
+
+// Force compile-time validation that roleAssignmentResource satisfies the resource.Resource interface.
+var _ resource.Resource = &roleAssignmentResource{}
+
+// Force compile-time validation that roleAssignmentResource satisfies the resource.ResourceWithConfigure interface.
+var _ resource.ResourceWithConfigure = &roleAssignmentResource{}
+
+// Force compile-time validation that roleAssignmentResource satisfies the resource.ResourceWithImportState interface.
+var _ resource.ResourceWithImportState = &roleAssignmentResource{}
+
+// roleAssignmentResourceModel defines the resource model.
+type roleAssignmentResourceModel struct {
+	// Computed field (user_email/role_id/scope[/scope_id])
+	ID types.String `tfsdk:"id"`
+
+	// Core fields
+	UserEmail types.String `tfsdk:"user_email"`
+	RoleID    types.String `tfsdk:"role_id"`
+	Scope     types.String `tfsdk:"scope"`
+	ScopeID   types.String `tfsdk:"scope_id"`
+
+	// Computed fields
+	AssignmentID types.String `tfsdk:"assignment_id"`
+	RoleName     types.String `tfsdk:"role_name"`
+	GrantedBy    types.String `tfsdk:"granted_by"`
+	GrantedAt    types.String `tfsdk:"granted_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewRoleAssignmentResource is a helper function to instantiate the role assignment resource.
+func NewRoleAssignmentResource() resource.Resource {
+	return &roleAssignmentResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *roleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_assignment"
+}
+
+// Schema defines the schema for the resource.
+func (r *roleAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assigns a ContextForge RBAC role to a user in a global, team or personal scope",
+		Description:         "Assigns a ContextForge RBAC role to a user in a global, team or personal scope",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed field
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Assignment identifier in the form user_email/role_id/scope, followed by /scope_id when set",
+				Description:         "Assignment identifier in the form user_email/role_id/scope, followed by /scope_id when set",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Core fields
+			"user_email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user",
+				Description:         "Email address of the user",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the role to assign",
+				Description:         "ID of the role to assign",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Scope of the assignment (global, team or personal)",
+				Description:         "Scope of the assignment (global, team or personal)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope_id": schema.StringAttribute{
+				MarkdownDescription: "Team ID the assignment applies to (required for the team scope)",
+				Description:         "Team ID the assignment applies to (required for the team scope)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed fields
+			"assignment_id": schema.StringAttribute{
+				MarkdownDescription: "Assignment record ID",
+				Description:         "Assignment record ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the assigned role",
+				Description:         "Name of the assigned role",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"granted_by": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user who granted the role",
+				Description:         "Email address of the user who granted the role",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"granted_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the role was granted (RFC3339 format)",
+				Description:         "Timestamp when the role was granted (RFC3339 format)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *roleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "role assignment", &resp.Diagnostics) {
+		return
+	}
+
+	var data roleAssignmentResourceModel
+
+	// Read plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !validRBACScope(path.Root("scope"), data.Scope, &resp.Diagnostics) {
+		return
+	}
+
+	if data.Scope.ValueString() == "team" && data.ScopeID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("scope_id"),
+			"Missing Scope ID",
+			"The scope_id attribute must be set to a team ID for team-scoped role assignments.",
+		)
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	userEmail := data.UserEmail.ValueString()
+
+	body := &rbacUserRoleRequest{
+		RoleID:  data.RoleID.ValueString(),
+		Scope:   data.Scope.ValueString(),
+		ScopeID: data.ScopeID.ValueStringPointer(),
+	}
+
+	// Assign the role
+	httpReq, err := r.client.NewRequest(http.MethodPost, fmt.Sprintf("rbac/users/%s/roles", url.PathEscape(userEmail)), body)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Assign Role",
+			fmt.Sprintf("Unable to assign role %s to %s; %v", body.RoleID, userEmail, err),
+		)
+		return
+	}
+
+	var assignment rbacUserRole
+	if _, err := r.client.Do(ctx, httpReq, &assignment); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Assign Role",
+			fmt.Sprintf("Unable to assign role %s to %s; %v", body.RoleID, userEmail, err),
+		)
+		return
+	}
+
+	// Map response to state
+	mapRoleAssignmentToState(&assignment, &data)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *roleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data roleAssignmentResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	assignment, httpResp, err := r.findAssignment(ctx, &data)
+	if err != nil {
+		// Handle 404 - user no longer exists
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Read Role Assignment",
+			fmt.Sprintf("Unable to read roles of %s; %v", data.UserEmail.ValueString(), err),
+		)
+		return
+	}
+
+	// Role was revoked
+	if assignment == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Map response to state
+	mapRoleAssignmentToState(assignment, &data)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called, as every configurable attribute requires replacement.
+func (r *roleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"Role assignments cannot be updated; changes to user_email, role_id, scope or scope_id replace the assignment.",
+	)
+}
+
+// Delete revokes the role and removes the Terraform state on success.
+func (r *roleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.readOnly, "delete", "role assignment", &resp.Diagnostics) {
+		return
+	}
+
+	var data roleAssignmentResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	userEmail := data.UserEmail.ValueString()
+	roleID := data.RoleID.ValueString()
+
+	query := url.Values{"scope": {data.Scope.ValueString()}}
+	if !data.ScopeID.IsNull() {
+		query.Set("scope_id", data.ScopeID.ValueString())
+	}
+
+	// Revoke the role
+	httpReq, err := r.client.NewRequest(http.MethodDelete, fmt.Sprintf("rbac/users/%s/roles/%s?%s", url.PathEscape(userEmail), url.PathEscape(roleID), query.Encode()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Revoke Role",
+			fmt.Sprintf("Unable to revoke role %s from %s; %v", roleID, userEmail, err),
+		)
+		return
+	}
+
+	httpResp, err := r.client.Do(ctx, httpReq, nil)
+	if err != nil {
+		// Ignore 404 errors (role already revoked)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Revoke Role",
+			fmt.Sprintf("Unable to revoke role %s from %s; %v", roleID, userEmail, err),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *roleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+// ImportState imports an existing role assignment using the ID
+// user_email/role_id/scope[/scope_id].
+func (r *roleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if (len(parts) != 3 && len(parts) != 4) || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID in the form user_email/role_id/scope or user_email/role_id/scope/scope_id, got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_email"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope"), parts[2])...)
+	if len(parts) == 4 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope_id"), parts[3])...)
+	}
+}
+
+// findAssignment looks up the active assignment matching the role, scope and scope ID of
+// data among the user's roles. Returns nil without an error when the role is not assigned.
+func (r *roleAssignmentResource) findAssignment(ctx context.Context, data *roleAssignmentResourceModel) (*rbacUserRole, *contextforge.Response, error) {
+	query := url.Values{"scope": {data.Scope.ValueString()}, "active_only": {"true"}}
+
+	req, err := r.client.NewRequest(http.MethodGet, fmt.Sprintf("rbac/users/%s/roles?%s", url.PathEscape(data.UserEmail.ValueString()), query.Encode()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var assignments []*rbacUserRole
+	resp, err := r.client.Do(ctx, req, &assignments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, a := range assignments {
+		if a.RoleID == data.RoleID.ValueString() && a.Scope == data.Scope.ValueString() &&
+			a.IsActive && stringPointerEqual(a.ScopeID, data.ScopeID.ValueStringPointer()) {
+			return a, resp, nil
+		}
+	}
+
+	return nil, resp, nil
+}
+
+// mapRoleAssignmentToState maps an API role assignment to the Terraform state model.
+func mapRoleAssignmentToState(assignment *rbacUserRole, data *roleAssignmentResourceModel) {
+	id := data.UserEmail.ValueString() + "/" + assignment.RoleID + "/" + assignment.Scope
+	if assignment.ScopeID != nil && *assignment.ScopeID != "" {
+		id += "/" + *assignment.ScopeID
+	}
+	data.ID = types.StringValue(id)
+
+	data.RoleID = types.StringValue(assignment.RoleID)
+	data.Scope = types.StringValue(assignment.Scope)
+	if assignment.ScopeID != nil && *assignment.ScopeID != "" {
+		data.ScopeID = types.StringPointerValue(assignment.ScopeID)
+	} else {
+		data.ScopeID = types.StringNull()
+	}
+
+	data.AssignmentID = types.StringValue(assignment.ID)
+	data.RoleName = types.StringPointerValue(assignment.RoleName)
+	data.GrantedBy = types.StringValue(assignment.GrantedBy)
+
+	if assignment.GrantedAt != nil && !assignment.GrantedAt.Time.IsZero() {
+		data.GrantedAt = types.StringValue(assignment.GrantedAt.Time.Format(time.RFC3339))
+	} else {
+		data.GrantedAt = types.StringNull()
+	}
+}
+
+// stringPointerEqual reports whether a and b are both nil (or empty) or hold the same string.
+func stringPointerEqual(a, b *string) bool {
+	var av, bv string
+	if a != nil {
+		av = *a
+	}
+	if b != nil {
+		bv = *b
+	}
+	return av == bv
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccRoleAssignmentResource_basic tests the lifecycle of a role assignment resource.
+// This test verifies:
+//   - Create assigns a team-scoped role to an existing user
+//   - Import using the user_email/role_id/scope/scope_id ID
+//   - Delete revokes the role
+//
+// Prerequisites:
+//   - CONTEXTFORGE_ADDR environment variable set
+//   - CONTEXTFORGE_TOKEN environment variable set
+//   - Integration test setup completed (creates the member@test.local user)
+//
+// To run:
+//   make integration-test-all  # Full lifecycle with setup/teardown
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccRoleAssignmentResource_basic
+func TestAccRoleAssignmentResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRoleAssignmentResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("contextforge_role_assignment.test", "user_email", "member@test.local"),
+					resource.TestCheckResourceAttrPair("contextforge_role_assignment.test", "role_id", "contextforge_role.test", "id"),
+					resource.TestCheckResourceAttr("contextforge_role_assignment.test", "scope", "team"),
+					resource.TestCheckResourceAttrPair("contextforge_role_assignment.test", "scope_id", "contextforge_team.test", "id"),
+					resource.TestCheckResourceAttrSet("contextforge_role_assignment.test", "assignment_id"),
+					resource.TestCheckResourceAttrSet("contextforge_role_assignment.test", "granted_by"),
+					resource.TestCheckResourceAttrSet("contextforge_role_assignment.test", "granted_at"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:      "contextforge_role_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccRoleAssignmentResource_missingScopeID tests that team-scoped assignments require
+// a scope_id.
+//
+// To run:
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccRoleAssignmentResource_missingScopeID
+func TestAccRoleAssignmentResource_missingScopeID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "contextforge_role_assignment" "test" {
+  user_email = "member@test.local"
+  role_id    = "any-role-id"
+  scope      = "team"
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing Scope ID`),
+			},
+		},
+	})
+}
+
+// testAccRoleAssignmentResourceConfig generates Terraform configuration that creates a
+// team and a team-scoped role, and assigns the role to the integration test member user
+// in that team.
+//
+// Returns:
+//   - HCL configuration string
+func testAccRoleAssignmentResourceConfig() string {
+	return `
+resource "contextforge_team" "test" {
+  name = "tf-test-team-role-assignment"
+}
+
+resource "contextforge_role" "test" {
+  name        = "tf-test-role-assignment"
+  scope       = "team"
+  permissions = ["tools.read"]
+}
+
+resource "contextforge_role_assignment" "test" {
+  user_email = "member@test.local"
+  role_id    = contextforge_role.test.id
+  scope      = "team"
+  scope_id   = contextforge_team.test.id
+}
+`
+}
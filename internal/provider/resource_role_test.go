@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccRoleResource_basic tests the full lifecycle for a role resource.
+// This test verifies:
+//   - Create with a scope and permissions
+//   - Update of the description and permissions in place
+//   - Import using the role ID
+//   - Delete removes the role
+//
+// Prerequisites:
+//   - CONTEXTFORGE_ADDR environment variable set
+//   - CONTEXTFORGE_TOKEN environment variable set
+//
+// To run:
+//   make integration-test-all  # Full lifecycle with setup/teardown
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccRoleResource_basic
+func TestAccRoleResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRoleResourceConfig("Read-only access to tools", `"tools.read"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("contextforge_role.test", "id"),
+					resource.TestCheckResourceAttr("contextforge_role.test", "name", "tf-test-role"),
+					resource.TestCheckResourceAttr("contextforge_role.test", "description", "Read-only access to tools"),
+					resource.TestCheckResourceAttr("contextforge_role.test", "scope", "team"),
+					resource.TestCheckResourceAttr("contextforge_role.test", "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("contextforge_role.test", "permissions.*", "tools.read"),
+					resource.TestCheckResourceAttr("contextforge_role.test", "is_system_role", "false"),
+					resource.TestCheckResourceAttr("contextforge_role.test", "is_active", "true"),
+					resource.TestCheckResourceAttrSet("contextforge_role.test", "created_by"),
+				),
+			},
+			// Update testing
+			{
+				Config: testAccRoleResourceConfig("Read and execute tools", `"tools.read", "tools.execute"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("contextforge_role.test", "description", "Read and execute tools"),
+					resource.TestCheckResourceAttr("contextforge_role.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("contextforge_role.test", "permissions.*", "tools.execute"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:      "contextforge_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccRoleResource_invalidScope tests that an unsupported scope is rejected.
+//
+// To run:
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccRoleResource_invalidScope
+func TestAccRoleResource_invalidScope(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "contextforge_role" "test" {
+  name        = "tf-test-role-invalid"
+  scope       = "server"
+  permissions = ["tools.read"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Scope`),
+			},
+		},
+	})
+}
+
+// testAccRoleResourceConfig generates Terraform configuration for a team-scoped role.
+//
+// Parameters:
+//   - description: Role description
+//   - permissions: Comma-separated, quoted permission strings
+//
+// Returns:
+//   - HCL configuration string
+func testAccRoleResourceConfig(description, permissions string) string {
+	return fmt.Sprintf(`
+resource "contextforge_role" "test" {
+  name        = "tf-test-role"
+  description = %[1]q
+  scope       = "team"
+  permissions = [%[2]s]
+}
+`, description, permissions)
+}
@@ -3,8 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,8 +20,15 @@ import (
 	"github.com/leefowlercu/go-contextforge/contextforge"
 )
 
+// serverAssociationModes are the supported values of association_mode.
+var serverAssociationModes = []string{"exclusive", "shared"}
+
 type serverResource struct {
-	client *contextforge.Client
+	client            *contextforge.Client
+	defaultTags       []string
+	defaultTeamID     string
+	defaultVisibility string
+	readOnly          bool
 }
 
 // Force compile-time validation that serverResource satisfies the resource.Resource interface.
@@ -29,6 +40,9 @@ var _ resource.ResourceWithConfigure = &serverResource{}
 // Force compile-time validation that serverResource satisfies the resource.ResourceWithImportState interface.
 var _ resource.ResourceWithImportState = &serverResource{}
 
+// Force compile-time validation that serverResource satisfies the resource.ResourceWithModifyPlan interface.
+var _ resource.ResourceWithModifyPlan = &serverResource{}
+
 // serverResourceModel defines the resource model.
 type serverResourceModel struct {
 	// Core fields
@@ -39,16 +53,18 @@ type serverResourceModel struct {
 	IsActive    types.Bool   `tfsdk:"is_active"`
 
 	// Association fields
-	AssociatedTools     types.List `tfsdk:"associated_tools"`
-	AssociatedResources types.List `tfsdk:"associated_resources"`
-	AssociatedPrompts   types.List `tfsdk:"associated_prompts"`
-	AssociatedA2aAgents types.List `tfsdk:"associated_a2a_agents"`
+	AssociatedTools     types.List   `tfsdk:"associated_tools"`
+	AssociatedResources types.List   `tfsdk:"associated_resources"`
+	AssociatedPrompts   types.List   `tfsdk:"associated_prompts"`
+	AssociatedA2aAgents types.List   `tfsdk:"associated_a2a_agents"`
+	AssociationMode     types.String `tfsdk:"association_mode"`
 
 	// Nested metrics
 	Metrics types.Object `tfsdk:"metrics"`
 
 	// Organizational fields
 	Tags       types.List   `tfsdk:"tags"`
+	TagsAll    types.List   `tfsdk:"tags_all"`
 	TeamID     types.String `tfsdk:"team_id"`
 	Team       types.String `tfsdk:"team"`
 	OwnerEmail types.String `tfsdk:"owner_email"`
@@ -70,6 +86,8 @@ type serverResourceModel struct {
 	ImportBatchID     types.String `tfsdk:"import_batch_id"`
 	FederationSource  types.String `tfsdk:"federation_source"`
 	Version           types.Int64  `tfsdk:"version"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewServerResource is a helper function to instantiate the server resource.
@@ -150,6 +168,11 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 				Computed:            true,
 			},
+			"association_mode": schema.StringAttribute{
+				MarkdownDescription: "How the association lists are managed: `exclusive` makes them the complete lists of associations, `shared` manages only the listed IDs and ignores associations added elsewhere, such as by `contextforge_server_association` (default: exclusive)",
+				Description:         "How the association lists are managed: exclusive makes them the complete lists of associations, shared manages only the listed IDs and ignores associations added elsewhere, such as by contextforge_server_association (default: exclusive)",
+				Optional:            true,
+			},
 
 			// Nested metrics
 			"metrics": schema.SingleNestedAttribute{
@@ -208,6 +231,7 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Optional:            true,
 				Computed:            true,
 			},
+			"tags_all": tagsAllSchemaAttribute(),
 			"team_id": schema.StringAttribute{
 				MarkdownDescription: "Team ID",
 				Description:         "Team ID",
@@ -300,7 +324,26 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan plans tags_all from the configured tags and the provider default tags, and
+// applies the provider default team and visibility to new servers.
+func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTagsAll(ctx, req, resp, r.defaultTags)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	modifyPlanTeamDefaults(ctx, req, resp, r.defaultTeamID, r.defaultVisibility)
 }
 
 // Configure configures the resource with the provider client.
@@ -309,26 +352,47 @@ func (r *serverResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*contextforge.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *contextforge.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.defaultTags = data.DefaultTags
+	r.defaultTeamID = data.DefaultTeamID
+	r.defaultVisibility = data.DefaultVisibility
+	r.readOnly = data.ReadOnly
 }
 
 // Create creates the server resource.
 func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "server", &resp.Diagnostics) {
+		return
+	}
+
 	var data serverResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if !validServerAssociationMode(data.AssociationMode, &resp.Diagnostics) {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Build ServerCreate from plan
 	server := &contextforge.ServerCreate{
 		Name: data.Name.ValueString(),
@@ -344,15 +408,14 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 		server.Icon = &icon
 	}
 
-	// Convert tags
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		var tags []string
-		diags := data.Tags.ElementsAs(ctx, &tags, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		server.Tags = tags
+	// Convert tags, merged with the provider default tags
+	tagNames, setTags, tagsDiags := requestTags(ctx, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if setTags {
+		server.Tags = tagNames
 	}
 
 	// Convert associated tools (string IDs)
@@ -421,6 +484,7 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Map created server to state
+	planned := data
 	data.ID = types.StringValue(createdServer.ID)
 	data.Name = types.StringValue(createdServer.Name)
 	data.Description = types.StringPointerValue(createdServer.Description)
@@ -460,6 +524,11 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 		data.AssociatedA2aAgents = types.ListNull(types.StringType)
 	}
 
+	// In shared mode, track only the associations owned by the configuration
+	if isSharedAssociationMode(data.AssociationMode) {
+		resp.Diagnostics.Append(mapSharedServerAssociations(ctx, r.client, &data, &planned, createdServer)...)
+	}
+
 	// Map metrics
 	if createdServer.Metrics != nil {
 		metricsObj, metricsDiags := mapMetricsToObject(ctx, createdServer.Metrics)
@@ -480,13 +549,10 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Map organizational fields
-	if createdServer.Tags != nil {
-		tagsList, diags := types.ListValueFrom(ctx, types.StringType, contextforge.TagNames(createdServer.Tags))
-		resp.Diagnostics.Append(diags...)
-		data.Tags = tagsList
-	} else {
-		data.Tags = types.ListNull(types.StringType)
-	}
+	tags, tagsAll, tagsDiags := stateTags(ctx, createdServer.Tags, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	data.Tags = tags
+	data.TagsAll = tagsAll
 
 	data.TeamID = types.StringPointerValue(createdServer.TeamID)
 	data.Team = types.StringPointerValue(createdServer.Team)
@@ -534,6 +600,16 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	prior := data
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	// Get server from API
 	server, httpResp, err := r.client.Servers.Get(ctx, data.ID.ValueString())
@@ -588,6 +664,11 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 		data.AssociatedA2aAgents = types.ListNull(types.StringType)
 	}
 
+	// In shared mode, track only the associations owned by the configuration
+	if isSharedAssociationMode(data.AssociationMode) {
+		resp.Diagnostics.Append(mapSharedServerAssociations(ctx, r.client, &data, &prior, server)...)
+	}
+
 	// Map metrics
 	if server.Metrics != nil {
 		metricsObj, metricsDiags := mapMetricsToObject(ctx, server.Metrics)
@@ -608,13 +689,10 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Map organizational fields
-	if server.Tags != nil {
-		tagsList, diags := types.ListValueFrom(ctx, types.StringType, contextforge.TagNames(server.Tags))
-		resp.Diagnostics.Append(diags...)
-		data.Tags = tagsList
-	} else {
-		data.Tags = types.ListNull(types.StringType)
-	}
+	tags, tagsAll, tagsDiags := stateTags(ctx, server.Tags, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	data.Tags = tags
+	data.TagsAll = tagsAll
 
 	data.TeamID = types.StringPointerValue(server.TeamID)
 	data.Team = types.StringPointerValue(server.Team)
@@ -657,15 +735,41 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 // Update updates the server resource.
 func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.readOnly, "update", "server", &resp.Diagnostics) {
+		return
+	}
+
 	var data serverResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	var state serverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !validServerAssociationMode(data.AssociationMode, &resp.Diagnostics) {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Build ServerUpdate from plan
 	server := &contextforge.ServerUpdate{}
 
+	// In shared mode, associations are reconciled after the server update
+	exclusive := !isSharedAssociationMode(data.AssociationMode)
+
 	// Name update
 	if !data.Name.IsNull() && !data.Name.IsUnknown() {
 		name := data.Name.ValueString()
@@ -684,19 +788,29 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 		server.Icon = &icon
 	}
 
-	// Tags update
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		var tags []string
-		diags := data.Tags.ElementsAs(ctx, &tags, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
+	// Tags update, merged with the provider default tags
+	tagNames, setTags, tagsDiags := requestTags(ctx, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if setTags {
+		server.Tags = tagNames
+	}
+
+	// An empty tag list is left out of the update request, so the tags are cleared first
+	if setTags && len(tagNames) == 0 {
+		if err := clearTags(ctx, r.client, "servers/"+url.PathEscape(data.ID.ValueString())); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Clear Tags",
+				fmt.Sprintf("Unable to clear tags of server with ID %s; %v", data.ID.ValueString(), err),
+			)
 			return
 		}
-		server.Tags = tags
 	}
 
 	// Associated tools update (string IDs)
-	if !data.AssociatedTools.IsNull() && !data.AssociatedTools.IsUnknown() {
+	if exclusive && !data.AssociatedTools.IsNull() && !data.AssociatedTools.IsUnknown() {
 		var tools []string
 		diags := data.AssociatedTools.ElementsAs(ctx, &tools, false)
 		resp.Diagnostics.Append(diags...)
@@ -707,7 +821,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Associated resources update (string IDs)
-	if !data.AssociatedResources.IsNull() && !data.AssociatedResources.IsUnknown() {
+	if exclusive && !data.AssociatedResources.IsNull() && !data.AssociatedResources.IsUnknown() {
 		var resources []string
 		diags := data.AssociatedResources.ElementsAs(ctx, &resources, false)
 		resp.Diagnostics.Append(diags...)
@@ -718,7 +832,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Associated prompts update (string IDs)
-	if !data.AssociatedPrompts.IsNull() && !data.AssociatedPrompts.IsUnknown() {
+	if exclusive && !data.AssociatedPrompts.IsNull() && !data.AssociatedPrompts.IsUnknown() {
 		var prompts []string
 		diags := data.AssociatedPrompts.ElementsAs(ctx, &prompts, false)
 		resp.Diagnostics.Append(diags...)
@@ -729,7 +843,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Associated A2A agents update (string IDs)
-	if !data.AssociatedA2aAgents.IsNull() && !data.AssociatedA2aAgents.IsUnknown() {
+	if exclusive && !data.AssociatedA2aAgents.IsNull() && !data.AssociatedA2aAgents.IsUnknown() {
 		var agents []string
 		diags := data.AssociatedA2aAgents.ElementsAs(ctx, &agents, false)
 		resp.Diagnostics.Append(diags...)
@@ -764,6 +878,15 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	owned := data
+	if !exclusive {
+		updatedServer, diags = updateSharedServerAssociations(ctx, r.client, &owned, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Map updated server to state (same as Read)
 	data.Name = types.StringValue(updatedServer.Name)
 	data.Description = types.StringPointerValue(updatedServer.Description)
@@ -803,6 +926,11 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 		data.AssociatedA2aAgents = types.ListNull(types.StringType)
 	}
 
+	// In shared mode, track only the associations owned by the configuration
+	if isSharedAssociationMode(data.AssociationMode) {
+		resp.Diagnostics.Append(mapSharedServerAssociations(ctx, r.client, &data, &owned, updatedServer)...)
+	}
+
 	// Map metrics
 	if updatedServer.Metrics != nil {
 		metricsObj, metricsDiags := mapMetricsToObject(ctx, updatedServer.Metrics)
@@ -823,13 +951,10 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Map organizational fields
-	if updatedServer.Tags != nil {
-		tagsList, diags := types.ListValueFrom(ctx, types.StringType, contextforge.TagNames(updatedServer.Tags))
-		resp.Diagnostics.Append(diags...)
-		data.Tags = tagsList
-	} else {
-		data.Tags = types.ListNull(types.StringType)
-	}
+	tags, tagsAll, tagsDiags := stateTags(ctx, updatedServer.Tags, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	data.Tags = tags
+	data.TagsAll = tagsAll
 
 	data.TeamID = types.StringPointerValue(updatedServer.TeamID)
 	data.Team = types.StringPointerValue(updatedServer.Team)
@@ -872,12 +997,25 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 // Delete deletes the server resource.
 func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.readOnly, "delete", "server", &resp.Diagnostics) {
+		return
+	}
+
 	var data serverResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete server via API
 	httpResp, err := r.client.Servers.Delete(ctx, data.ID.ValueString())
 	if err != nil {
@@ -954,3 +1092,150 @@ func mapMetricsToObject(ctx context.Context, metrics *contextforge.ServerMetrics
 
 	return objValue, diags
 }
+
+// validServerAssociationMode reports whether association_mode is unset or supported, adding
+// an attribute error otherwise.
+func validServerAssociationMode(mode types.String, diags *diag.Diagnostics) bool {
+	if mode.IsNull() || mode.IsUnknown() || slices.Contains(serverAssociationModes, mode.ValueString()) {
+		return true
+	}
+
+	diags.AddAttributeError(
+		path.Root("association_mode"),
+		"Invalid Association Mode",
+		fmt.Sprintf("The association mode must be one of %s, got %q.", strings.Join(serverAssociationModes, ", "), mode.ValueString()),
+	)
+	return false
+}
+
+// isSharedAssociationMode reports whether association_mode is shared.
+func isSharedAssociationMode(mode types.String) bool {
+	return mode.ValueString() == "shared"
+}
+
+// serverAssociationLists returns the association lists of a server model, keyed by kind.
+func serverAssociationLists(data *serverResourceModel) map[string]*types.List {
+	return map[string]*types.List{
+		"tool":      &data.AssociatedTools,
+		"resource":  &data.AssociatedResources,
+		"prompt":    &data.AssociatedPrompts,
+		"a2a_agent": &data.AssociatedA2aAgents,
+	}
+}
+
+// mapSharedServerAssociations limits the association lists in data to the IDs listed in
+// owned that are still associated with the server, in the order of owned, so that
+// associations added elsewhere are not reported as drift.
+func mapSharedServerAssociations(ctx context.Context, client *contextforge.Client, data, owned *serverResourceModel, server *contextforge.Server) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ownedLists := serverAssociationLists(owned)
+	for kind, list := range serverAssociationLists(data) {
+		if ownedLists[kind].IsNull() || ownedLists[kind].IsUnknown() {
+			*list = types.ListNull(types.StringType)
+			continue
+		}
+
+		var ownedIDs []string
+		diags.Append(ownedLists[kind].ElementsAs(ctx, &ownedIDs, false)...)
+
+		ids, err := serverAssociationIDs(ctx, client, server, kind)
+		if err != nil {
+			diags.AddError(
+				"Failed to Read Server Associations",
+				fmt.Sprintf("Unable to read the %s associations of server %s; %v", kind, server.ID, err),
+			)
+			return diags
+		}
+
+		kept := make([]string, 0, len(ownedIDs))
+		for _, id := range ownedIDs {
+			if slices.Contains(ids, id) {
+				kept = append(kept, id)
+			}
+		}
+
+		value, valueDiags := types.ListValueFrom(ctx, types.StringType, kept)
+		diags.Append(valueDiags...)
+		*list = value
+	}
+
+	return diags
+}
+
+// updateSharedServerAssociations reconciles the associations of a server in shared mode:
+// IDs listed in state but no longer in owned are removed, IDs listed in owned are added,
+// and associations added elsewhere are kept. Lists that are unknown in owned are left
+// unchanged and set to their value in state.
+func updateSharedServerAssociations(ctx context.Context, client *contextforge.Client, owned, state *serverResourceModel) (*contextforge.Server, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serverID := owned.ID.ValueString()
+
+	unlock := lockServerAssociations(serverID)
+	defer unlock()
+
+	server, _, err := client.Servers.Get(ctx, serverID)
+	if err != nil {
+		diags.AddError(
+			"Failed to Read Server",
+			fmt.Sprintf("Unable to read server; %v", err),
+		)
+		return nil, diags
+	}
+
+	ownedLists := serverAssociationLists(owned)
+	previousLists := serverAssociationLists(state)
+	for _, kind := range serverAssociationKindNames() {
+		if ownedLists[kind].IsUnknown() {
+			*ownedLists[kind] = *previousLists[kind]
+		}
+
+		var ownedIDs, previousIDs []string
+		if !ownedLists[kind].IsNull() {
+			diags.Append(ownedLists[kind].ElementsAs(ctx, &ownedIDs, false)...)
+		}
+		if !previousLists[kind].IsNull() && !previousLists[kind].IsUnknown() {
+			diags.Append(previousLists[kind].ElementsAs(ctx, &previousIDs, false)...)
+		}
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		current, err := serverAssociationIDs(ctx, client, server, kind)
+		if err != nil {
+			diags.AddError(
+				"Failed to Read Server Associations",
+				fmt.Sprintf("Unable to read the %s associations of server %s; %v", kind, serverID, err),
+			)
+			return nil, diags
+		}
+
+		ids := make([]string, 0, len(current)+len(ownedIDs))
+		for _, id := range current {
+			if !slices.Contains(previousIDs, id) || slices.Contains(ownedIDs, id) {
+				ids = append(ids, id)
+			}
+		}
+		for _, id := range ownedIDs {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+
+		if slices.Equal(ids, current) {
+			continue
+		}
+
+		server, _, err = setServerAssociations(ctx, client, serverID, kind, ids)
+		if err != nil {
+			diags.AddError(
+				"Failed to Update Server Associations",
+				fmt.Sprintf("Unable to update the %s associations of server %s; %v", kind, serverID, err),
+			)
+			return nil, diags
+		}
+	}
+
+	return server, diags
+}
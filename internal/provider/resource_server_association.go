@@ -0,0 +1,472 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+// serverAssociationKinds are the kinds of objects that can be associated with a server,
+// mapped to the field of the server update request that holds their IDs.
+var serverAssociationKinds = map[string]string{
+	"tool":      "associatedTools",
+	"resource":  "associatedResources",
+	"prompt":    "associatedPrompts",
+	"a2a_agent": "associatedA2aAgents",
+}
+
+// serverAssociationLocks serializes association changes per server ID. Associations are
+// updated by rewriting the server's whole list, so concurrent changes to the same server
+// would otherwise overwrite each other.
+var serverAssociationLocks sync.Map
+
+type serverAssociationResource struct {
+	client   *contextforge.Client
+	readOnly bool
+}
+
+// Force compile-time validation that serverAssociationResource satisfies the resource.Resource interface.
+var _ resource.Resource = &serverAssociationResource{}
+
+// Force compile-time validation that serverAssociationResource satisfies the resource.ResourceWithConfigure interface.
+var _ resource.ResourceWithConfigure = &serverAssociationResource{}
+
+// Force compile-time validation that serverAssociationResource satisfies the resource.ResourceWithImportState interface.
+var _ resource.ResourceWithImportState = &serverAssociationResource{}
+
+// serverAssociationResourceModel defines the resource model.
+type serverAssociationResourceModel struct {
+	// Computed field (server_id/kind/member_id)
+	ID types.String `tfsdk:"id"`
+
+	// Core fields
+	ServerID types.String `tfsdk:"server_id"`
+	Kind     types.String `tfsdk:"kind"`
+	MemberID types.String `tfsdk:"member_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewServerAssociationResource is a helper function to instantiate the server association resource.
+func NewServerAssociationResource() resource.Resource {
+	return &serverAssociationResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *serverAssociationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_association"
+}
+
+// Schema defines the schema for the resource.
+func (r *serverAssociationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Associates a single tool, resource, prompt or A2A agent with a ContextForge virtual server, leaving the server's other associations untouched",
+		Description:         "Associates a single tool, resource, prompt or A2A agent with a ContextForge virtual server, leaving the server's other associations untouched",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed field
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Association identifier in the form server_id/kind/member_id",
+				Description:         "Association identifier in the form server_id/kind/member_id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Core fields
+			"server_id": schema.StringAttribute{
+				MarkdownDescription: "Server ID",
+				Description:         "Server ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				MarkdownDescription: "Kind of the associated object (tool, resource, prompt or a2a_agent)",
+				Description:         "Kind of the associated object (tool, resource, prompt or a2a_agent)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"member_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the associated tool, resource, prompt or A2A agent",
+				Description:         "ID of the associated tool, resource, prompt or A2A agent",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *serverAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "server association", &resp.Diagnostics) {
+		return
+	}
+
+	var data serverAssociationResourceModel
+
+	// Read plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID := data.ServerID.ValueString()
+	kind := data.Kind.ValueString()
+	memberID := data.MemberID.ValueString()
+
+	if _, ok := serverAssociationKinds[kind]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("kind"),
+			"Invalid Association Kind",
+			fmt.Sprintf("The kind must be one of %s, got %q.", strings.Join(serverAssociationKindNames(), ", "), kind),
+		)
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	unlock := lockServerAssociations(serverID)
+	defer unlock()
+
+	server, _, err := r.client.Servers.Get(ctx, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Server",
+			fmt.Sprintf("Unable to read server with ID %s; %v", serverID, err),
+		)
+		return
+	}
+
+	// Existing associations must be imported, so that destroying this resource does not
+	// remove an association another configuration relies on
+	ids, err := serverAssociationIDs(ctx, r.client, server, kind)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Server Associations",
+			fmt.Sprintf("Unable to read the %s associations of server %s; %v", kind, serverID, err),
+		)
+		return
+	}
+	if slices.Contains(ids, memberID) {
+		resp.Diagnostics.AddError(
+			"Server Association Already Exists",
+			fmt.Sprintf("The %s %s is already associated with server %s. Import it with the ID %s/%s/%s to manage it.", kind, memberID, serverID, serverID, kind, memberID),
+		)
+		return
+	}
+
+	// Add the association
+	if _, _, err := setServerAssociations(ctx, r.client, serverID, kind, append(ids, memberID)); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create Server Association",
+			fmt.Sprintf("Unable to associate %s %s with server %s; %v", kind, memberID, serverID, err),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(serverID + "/" + kind + "/" + memberID)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *serverAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data serverAssociationResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	server, httpResp, err := r.client.Servers.Get(ctx, data.ServerID.ValueString())
+	if err != nil {
+		// Handle 404 - server no longer exists
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Read Server",
+			fmt.Sprintf("Unable to read server with ID %s; %v", data.ServerID.ValueString(), err),
+		)
+		return
+	}
+
+	ids, err := serverAssociationIDs(ctx, r.client, server, data.Kind.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Server Associations",
+			fmt.Sprintf("Unable to read the %s associations of server %s; %v", data.Kind.ValueString(), data.ServerID.ValueString(), err),
+		)
+		return
+	}
+
+	// Association was removed
+	if !slices.Contains(ids, data.MemberID.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ID = types.StringValue(data.ServerID.ValueString() + "/" + data.Kind.ValueString() + "/" + data.MemberID.ValueString())
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called, as every configurable attribute requires replacement.
+func (r *serverAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"Server associations cannot be updated; changes to server_id, kind or member_id replace the association.",
+	)
+}
+
+// Delete removes the association and the Terraform state on success.
+func (r *serverAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.readOnly, "delete", "server association", &resp.Diagnostics) {
+		return
+	}
+
+	var data serverAssociationResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	serverID := data.ServerID.ValueString()
+	kind := data.Kind.ValueString()
+	memberID := data.MemberID.ValueString()
+
+	unlock := lockServerAssociations(serverID)
+	defer unlock()
+
+	server, httpResp, err := r.client.Servers.Get(ctx, serverID)
+	if err != nil {
+		// Ignore 404 errors (server already deleted)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Read Server",
+			fmt.Sprintf("Unable to read server with ID %s; %v", serverID, err),
+		)
+		return
+	}
+
+	ids, err := serverAssociationIDs(ctx, r.client, server, kind)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Server Associations",
+			fmt.Sprintf("Unable to read the %s associations of server %s; %v", kind, serverID, err),
+		)
+		return
+	}
+	if !slices.Contains(ids, memberID) {
+		return
+	}
+
+	// Remove the association
+	remaining := slices.DeleteFunc(slices.Clone(ids), func(id string) bool { return id == memberID })
+	_, httpResp, err = setServerAssociations(ctx, r.client, serverID, kind, remaining)
+	if err != nil {
+		// Ignore 404 errors (server already deleted)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Delete Server Association",
+			fmt.Sprintf("Unable to remove %s %s from server %s; %v", kind, memberID, serverID, err),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *serverAssociationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+// ImportState imports an existing association using the ID server_id/kind/member_id.
+func (r *serverAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID in the form server_id/kind/member_id, got %q", req.ID),
+		)
+		return
+	}
+
+	if _, ok := serverAssociationKinds[parts[1]]; !ok {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("The kind must be one of %s, got %q.", strings.Join(serverAssociationKindNames(), ", "), parts[1]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("kind"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_id"), parts[2])...)
+}
+
+// lockServerAssociations locks association changes to a server and returns the unlock function.
+func lockServerAssociations(serverID string) func() {
+	mu, _ := serverAssociationLocks.LoadOrStore(serverID, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+	return mu.(*sync.Mutex).Unlock
+}
+
+// serverAssociationKindNames returns the supported association kinds in sorted order.
+func serverAssociationKindNames() []string {
+	names := make([]string, 0, len(serverAssociationKinds))
+	for kind := range serverAssociationKinds {
+		names = append(names, kind)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// serverAssociationIDs returns the IDs of the objects of a kind associated with a server.
+// The server API accepts tool IDs but reports associated tools by name, so tool names are
+// resolved to IDs using the tool list; names that match no tool are returned unchanged.
+func serverAssociationIDs(ctx context.Context, client *contextforge.Client, server *contextforge.Server, kind string) ([]string, error) {
+	switch kind {
+	case "resource":
+		return server.AssociatedResources, nil
+	case "prompt":
+		return server.AssociatedPrompts, nil
+	case "a2a_agent":
+		return server.AssociatedA2aAgents, nil
+	case "tool":
+	default:
+		return nil, nil
+	}
+
+	if len(server.AssociatedTools) == 0 {
+		return nil, nil
+	}
+
+	toolIDs := make(map[string]string)
+	opts := &contextforge.ToolListOptions{IncludeInactive: true}
+	for {
+		tools, resp, err := client.Tools.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tool := range tools {
+			toolIDs[tool.Name] = tool.ID
+		}
+		if resp.NextCursor == "" {
+			break
+		}
+		opts.Cursor = resp.NextCursor
+	}
+
+	ids := make([]string, 0, len(server.AssociatedTools))
+	for _, name := range server.AssociatedTools {
+		if id, ok := toolIDs[name]; ok {
+			ids = append(ids, id)
+		} else {
+			ids = append(ids, name)
+		}
+	}
+
+	return ids, nil
+}
+
+// setServerAssociations replaces the IDs of the objects of a kind associated with a server,
+// leaving its other fields unchanged. The SDK's ServerUpdate omits empty lists, so the
+// request is built directly to allow removing the last association.
+func setServerAssociations(ctx context.Context, client *contextforge.Client, serverID, kind string, ids []string) (*contextforge.Server, *contextforge.Response, error) {
+	if ids == nil {
+		ids = []string{}
+	}
+
+	req, err := client.NewRequest(http.MethodPut, "servers/"+url.PathEscape(serverID), map[string][]string{
+		serverAssociationKinds[kind]: ids,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var server *contextforge.Server
+	resp, err := client.Do(ctx, req, &server)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return server, resp, nil
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccServerAssociationResource_basic tests the lifecycle of a server association
+// alongside a server in shared association mode.
+// This test verifies:
+//   - Create adds the association without touching the server's own associations
+//   - The server does not report the association as drift
+//   - Import using the server_id/kind/member_id ID
+//   - Delete removes only the association
+//
+// Prerequisites:
+//   - CONTEXTFORGE_TEST_RESOURCE_ID environment variable set (from integration test setup)
+//   - CONTEXTFORGE_TEST_PROMPT_ID environment variable set (from integration test setup)
+//
+// To run:
+//   make integration-test-all  # Full lifecycle with setup/teardown
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccServerAssociationResource_basic
+func TestAccServerAssociationResource_basic(t *testing.T) {
+	resourceID := os.Getenv("CONTEXTFORGE_TEST_RESOURCE_ID")
+	promptID := os.Getenv("CONTEXTFORGE_TEST_PROMPT_ID")
+
+	if resourceID == "" || promptID == "" {
+		t.Skip("Skipping server association test - requires CONTEXTFORGE_TEST_RESOURCE_ID and CONTEXTFORGE_TEST_PROMPT_ID environment variables")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccServerAssociationResourceConfig(resourceID, promptID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("contextforge_server_association.test", "kind", "resource"),
+					resource.TestCheckResourceAttr("contextforge_server_association.test", "member_id", resourceID),
+					resource.TestCheckResourceAttrPair("contextforge_server_association.test", "server_id", "contextforge_server.test", "id"),
+					resource.TestCheckResourceAttr("contextforge_server.test", "associated_prompts.#", "1"),
+					resource.TestCheckResourceAttr("contextforge_server.test", "associated_prompts.0", promptID),
+				),
+			},
+			// Refresh: the server ignores the association it does not own
+			{
+				Config:             testAccServerAssociationResourceConfig(resourceID, promptID),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			// Import testing
+			{
+				ResourceName:      "contextforge_server_association.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccServerAssociationResource_invalidKind tests that an unsupported kind is rejected.
+//
+// To run:
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccServerAssociationResource_invalidKind
+func TestAccServerAssociationResource_invalidKind(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "contextforge_server" "test" {
+  name = "tf-test-server-association-invalid"
+}
+
+resource "contextforge_server_association" "test" {
+  server_id = contextforge_server.test.id
+  kind      = "gateway"
+  member_id = "does-not-matter"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Association Kind`),
+			},
+		},
+	})
+}
+
+// testAccServerAssociationResourceConfig generates Terraform configuration for a server in
+// shared association mode that owns a prompt, and an association adding a resource to it.
+//
+// Parameters:
+//   - resourceID: ID of an existing resource to associate (string UUID)
+//   - promptID: ID of an existing prompt owned by the server (string UUID)
+//
+// Returns:
+//   - HCL configuration string
+func testAccServerAssociationResourceConfig(resourceID, promptID string) string {
+	return fmt.Sprintf(`
+resource "contextforge_server" "test" {
+  name               = "tf-test-server-association"
+  association_mode   = "shared"
+  associated_prompts = [%[2]q]
+}
+
+resource "contextforge_server_association" "test" {
+  server_id = contextforge_server.test.id
+  kind      = "resource"
+  member_id = %[1]q
+}
+`, resourceID, promptID)
+}
@@ -0,0 +1,543 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+	"github.com/leefowlercu/terraform-provider-contextforge/internal/tfconv"
+)
+
+// teamListPageSize is the page size used when listing teams (the API maximum).
+const teamListPageSize = 100
+
+type teamResource struct {
+	client   *contextforge.Client
+	readOnly bool
+	features gatewayFeatures
+}
+
+// Force compile-time validation that teamResource satisfies the resource.Resource interface.
+var _ resource.Resource = &teamResource{}
+
+// Force compile-time validation that teamResource satisfies the resource.ResourceWithConfigure interface.
+var _ resource.ResourceWithConfigure = &teamResource{}
+
+// Force compile-time validation that teamResource satisfies the resource.ResourceWithImportState interface.
+var _ resource.ResourceWithImportState = &teamResource{}
+
+// teamResourceModel defines the resource model.
+type teamResourceModel struct {
+	// Computed field
+	ID types.String `tfsdk:"id"`
+
+	// Core fields
+	Name        types.String `tfsdk:"name"`
+	Slug        types.String `tfsdk:"slug"`
+	Description types.String `tfsdk:"description"`
+	Visibility  types.String `tfsdk:"visibility"`
+	MaxMembers  types.Int64  `tfsdk:"max_members"`
+
+	// Computed fields
+	MemberCount types.Int64  `tfsdk:"member_count"`
+	IsPersonal  types.Bool   `tfsdk:"is_personal"`
+	IsActive    types.Bool   `tfsdk:"is_active"`
+	CreatedBy   types.String `tfsdk:"created_by"`
+
+	// Timestamps
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewTeamResource is a helper function to instantiate the team resource.
+func NewTeamResource() resource.Resource {
+	return &teamResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *teamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+// Schema defines the schema for the resource.
+func (r *teamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ContextForge team",
+		Description:         "Manages a ContextForge team",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed field
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Team ID",
+				Description:         "Team ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Core fields
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Team name",
+				Description:         "Team name",
+				Required:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "Team slug (URL-friendly identifier, generated from the name when not set) - can only be set at creation",
+				Description:         "Team slug (URL-friendly identifier, generated from the name when not set) - can only be set at creation",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Team description",
+				Description:         "Team description",
+				Optional:            true,
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: "Visibility setting (private or public, default: private)",
+				Description:         "Visibility setting (private or public, default: private)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_members": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of members allowed in the team",
+				Description:         "Maximum number of members allowed in the team",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Computed fields
+			"member_count": schema.Int64Attribute{
+				MarkdownDescription: "Current number of members in the team",
+				Description:         "Current number of members in the team",
+				Computed:            true,
+			},
+			"is_personal": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is a personal team",
+				Description:         "Whether this is a personal team",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the team is active",
+				Description:         "Whether the team is active",
+				Computed:            true,
+			},
+			"created_by": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user who created the team",
+				Description:         "Email address of the user who created the team",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Timestamps
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp (RFC3339 format)",
+				Description:         "Creation timestamp (RFC3339 format)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Last update timestamp (RFC3339 format)",
+				Description:         "Last update timestamp (RFC3339 format)",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "team", &resp.Diagnostics) {
+		return
+	}
+
+	var data teamResourceModel
+
+	// Read plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Build the team create request
+	teamCreate := &contextforge.TeamCreate{
+		Name: data.Name.ValueString(),
+	}
+
+	// Optional fields
+	if !data.Slug.IsNull() && !data.Slug.IsUnknown() {
+		slug := data.Slug.ValueString()
+		teamCreate.Slug = &slug
+	}
+
+	if !data.Description.IsNull() && !data.Description.IsUnknown() {
+		desc := data.Description.ValueString()
+		teamCreate.Description = &desc
+	}
+
+	if !data.Visibility.IsNull() && !data.Visibility.IsUnknown() {
+		visibility := data.Visibility.ValueString()
+		teamCreate.Visibility = &visibility
+	}
+
+	if !data.MaxMembers.IsNull() && !data.MaxMembers.IsUnknown() {
+		maxMembers := int(data.MaxMembers.ValueInt64())
+		teamCreate.MaxMembers = &maxMembers
+	}
+
+	// Create the team
+	createdTeam, _, err := r.client.Teams.Create(ctx, teamCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create Team",
+			fmt.Sprintf("Unable to create team; %v", err),
+		)
+		return
+	}
+
+	// Map response to state
+	mapTeamToState(createdTeam, &data)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data teamResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	team, err := findTeam(ctx, r.client, r.features, data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Team",
+			fmt.Sprintf("Unable to read team with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+
+	// Team no longer exists
+	if team == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Map response to state
+	mapTeamToState(team, &data)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.readOnly, "update", "team", &resp.Diagnostics) {
+		return
+	}
+
+	var data teamResourceModel
+
+	// Read plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Build the team update request using three-state semantics
+	name := data.Name.ValueString()
+	teamUpdate := &contextforge.TeamUpdate{
+		Name: &name,
+	}
+
+	if !data.Description.IsUnknown() {
+		if data.Description.IsNull() {
+			emptyStr := ""
+			teamUpdate.Description = &emptyStr // Explicitly clear
+		} else {
+			desc := data.Description.ValueString()
+			teamUpdate.Description = &desc
+		}
+	}
+
+	if !data.Visibility.IsNull() && !data.Visibility.IsUnknown() {
+		visibility := data.Visibility.ValueString()
+		teamUpdate.Visibility = &visibility
+	}
+
+	if !data.MaxMembers.IsNull() && !data.MaxMembers.IsUnknown() {
+		maxMembers := int(data.MaxMembers.ValueInt64())
+		teamUpdate.MaxMembers = &maxMembers
+	}
+
+	// Update the team
+	teamID := data.ID.ValueString()
+	updatedTeam, _, err := r.client.Teams.Update(ctx, teamID, teamUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update Team",
+			fmt.Sprintf("Unable to update team with ID %s; %v", teamID, err),
+		)
+		return
+	}
+
+	// Map response to state
+	mapTeamToState(updatedTeam, &data)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.readOnly, "delete", "team", &resp.Diagnostics) {
+		return
+	}
+
+	var data teamResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete the team
+	httpResp, err := r.client.Teams.Delete(ctx, data.ID.ValueString())
+	if err != nil {
+		// Ignore 404 errors (team already deleted)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Delete Team",
+			fmt.Sprintf("Unable to delete team with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+	r.features = data.Features
+}
+
+// ImportState imports an existing team by ID or slug.
+func (r *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Team IDs are hex UUIDs, which are also valid slugs, so IDs are matched first
+	teams, err := listAllTeams(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to List Teams",
+			fmt.Sprintf("Unable to list teams; %v", err),
+		)
+		return
+	}
+
+	var team *contextforge.Team
+	for _, t := range teams {
+		if t.ID == req.ID {
+			team = t
+			break
+		}
+	}
+	if team == nil {
+		for _, t := range teams {
+			if t.Slug == req.ID {
+				team = t
+				break
+			}
+		}
+	}
+
+	if team == nil {
+		resp.Diagnostics.AddError(
+			"Team Not Found",
+			fmt.Sprintf("Unable to find team with ID or slug %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), team.ID)...)
+}
+
+// findTeam looks up a team by ID. Gateways without a working Get endpoint (see
+// capabilityTeamGet) are queried with List and filter instead. Returns nil without
+// an error when the team does not exist.
+func findTeam(ctx context.Context, client *contextforge.Client, features gatewayFeatures, teamID string) (*contextforge.Team, error) {
+	if features.supports(capabilityTeamGet) {
+		team, httpResp, err := client.Teams.Get(ctx, teamID)
+		if err != nil {
+			if httpResp != nil && httpResp.StatusCode == 404 {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return team, nil
+	}
+
+	teams, err := listAllTeams(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range teams {
+		if t.ID == teamID {
+			return t, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// listAllTeams returns every team visible to the caller. The teams endpoint uses
+// skip/limit pagination, so pages are requested until a short page is returned.
+func listAllTeams(ctx context.Context, client *contextforge.Client) ([]*contextforge.Team, error) {
+	var all []*contextforge.Team
+	opts := &contextforge.TeamListOptions{Limit: teamListPageSize}
+
+	for {
+		teams, _, err := client.Teams.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, teams...)
+		if len(teams) < teamListPageSize {
+			return all, nil
+		}
+		opts.Skip += len(teams)
+	}
+}
+
+// mapTeamToState maps SDK Team to Terraform state model.
+func mapTeamToState(team *contextforge.Team, data *teamResourceModel) {
+	data.ID = types.StringValue(team.ID)
+	data.Name = types.StringValue(team.Name)
+	data.Slug = types.StringValue(team.Slug)
+
+	// A description cleared by Update is returned as an empty string; keep it null
+	if team.Description == nil || (*team.Description == "" && data.Description.IsNull()) {
+		data.Description = types.StringNull()
+	} else {
+		data.Description = types.StringValue(*team.Description)
+	}
+	data.Visibility = types.StringPointerValue(team.Visibility)
+
+	// Convert max_members (*int → types.Int64)
+	if team.MaxMembers != nil {
+		data.MaxMembers = types.Int64PointerValue(tfconv.Int64Ptr(*team.MaxMembers))
+	} else {
+		data.MaxMembers = types.Int64Null()
+	}
+
+	data.MemberCount = types.Int64Value(int64(team.MemberCount))
+	data.IsPersonal = types.BoolValue(team.IsPersonal)
+	data.IsActive = types.BoolValue(team.IsActive)
+	data.CreatedBy = types.StringValue(team.CreatedBy)
+
+	// Map timestamps (convert to RFC3339 string)
+	if team.CreatedAt != nil && !team.CreatedAt.Time.IsZero() {
+		data.CreatedAt = types.StringValue(team.CreatedAt.Time.Format(time.RFC3339))
+	} else {
+		data.CreatedAt = types.StringNull()
+	}
+
+	if team.UpdatedAt != nil && !team.UpdatedAt.Time.IsZero() {
+		data.UpdatedAt = types.StringValue(team.UpdatedAt.Time.Format(time.RFC3339))
+	} else {
+		data.UpdatedAt = types.StringNull()
+	}
+}
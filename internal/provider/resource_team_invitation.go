@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+type teamInvitationResource struct {
+	client   *contextforge.Client
+	readOnly bool
+}
+
+// Force compile-time validation that teamInvitationResource satisfies the resource.Resource interface.
+var _ resource.Resource = &teamInvitationResource{}
+
+// Force compile-time validation that teamInvitationResource satisfies the resource.ResourceWithConfigure interface.
+var _ resource.ResourceWithConfigure = &teamInvitationResource{}
+
+// Force compile-time validation that teamInvitationResource satisfies the resource.ResourceWithImportState interface.
+var _ resource.ResourceWithImportState = &teamInvitationResource{}
+
+// teamInvitationResourceModel defines the resource model.
+type teamInvitationResourceModel struct {
+	// Computed field
+	ID types.String `tfsdk:"id"`
+
+	// Core fields
+	TeamID types.String `tfsdk:"team_id"`
+	Email  types.String `tfsdk:"email"`
+	Role   types.String `tfsdk:"role"`
+
+	// Computed fields
+	TeamName  types.String `tfsdk:"team_name"`
+	InvitedBy types.String `tfsdk:"invited_by"`
+	Token     types.String `tfsdk:"token"`
+	AcceptURL types.String `tfsdk:"accept_url"`
+
+	// Timestamps
+	InvitedAt types.String `tfsdk:"invited_at"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewTeamInvitationResource is a helper function to instantiate the team invitation resource.
+func NewTeamInvitationResource() resource.Resource {
+	return &teamInvitationResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *teamInvitationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_invitation"
+}
+
+// Schema defines the schema for the resource.
+func (r *teamInvitationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a pending invitation to join a ContextForge team. The invitation is removed from state once it is accepted or expires.",
+		Description:         "Manages a pending invitation to join a ContextForge team. The invitation is removed from state once it is accepted or expires.",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed field
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Invitation ID",
+				Description:         "Invitation ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Core fields
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team ID",
+				Description:         "Team ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user to invite",
+				Description:         "Email address of the user to invite",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role the user receives on accepting (owner or member, default: member)",
+				Description:         "Role the user receives on accepting (owner or member, default: member)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Computed fields
+			"team_name": schema.StringAttribute{
+				MarkdownDescription: "Team name",
+				Description:         "Team name",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invited_by": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user who sent the invitation",
+				Description:         "Email address of the user who sent the invitation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Invitation token",
+				Description:         "Invitation token",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"accept_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the API endpoint the invited user sends a `POST` request to, with their own credentials, to accept the invitation",
+				Description:         "URL of the API endpoint the invited user sends a POST request to, with their own credentials, to accept the invitation",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Timestamps
+			"invited_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the invitation was sent (RFC3339 format)",
+				Description:         "Timestamp when the invitation was sent (RFC3339 format)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the invitation expires (RFC3339 format)",
+				Description:         "Timestamp when the invitation expires (RFC3339 format)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *teamInvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "team invitation", &resp.Diagnostics) {
+		return
+	}
+
+	var data teamInvitationResourceModel
+
+	// Read plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Build the invitation request
+	invite := &contextforge.TeamInvite{
+		Email: data.Email.ValueString(),
+	}
+
+	if !data.Role.IsNull() && !data.Role.IsUnknown() {
+		role := data.Role.ValueString()
+		if !validTeamRole(role, &resp.Diagnostics) {
+			return
+		}
+		invite.Role = &role
+	}
+
+	// Create the invitation
+	teamID := data.TeamID.ValueString()
+	invitation, _, err := r.client.Teams.InviteMember(ctx, teamID, invite)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create Team Invitation",
+			fmt.Sprintf("Unable to invite %s to team %s; %v", data.Email.ValueString(), teamID, err),
+		)
+		return
+	}
+
+	// Map response to state
+	r.mapInvitationToState(invitation, &data)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *teamInvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data teamInvitationResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get invitation using List and filter (no Get endpoint for invitations)
+	invitations, httpResp, err := r.client.Teams.ListInvitations(ctx, data.TeamID.ValueString())
+	if err != nil {
+		// Handle 404 - team no longer exists
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to List Team Invitations",
+			fmt.Sprintf("Unable to list invitations of team %s; %v", data.TeamID.ValueString(), err),
+		)
+		return
+	}
+
+	var invitation *contextforge.TeamInvitation
+	for _, inv := range invitations {
+		if inv.ID == data.ID.ValueString() {
+			invitation = inv
+			break
+		}
+	}
+
+	// Invitation was accepted, cancelled or has expired
+	if invitation == nil || !invitationPending(invitation, time.Now()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Map response to state
+	r.mapInvitationToState(invitation, &data)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never called, as every configurable attribute requires replacement.
+func (r *teamInvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"Team invitations cannot be updated; changes to team_id, email or role replace the invitation.",
+	)
+}
+
+// Delete revokes the invitation and removes the Terraform state on success.
+func (r *teamInvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.readOnly, "delete", "team invitation", &resp.Diagnostics) {
+		return
+	}
+
+	var data teamInvitationResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Cancel the invitation
+	httpResp, err := r.client.Teams.CancelInvitation(ctx, data.ID.ValueString())
+	if err != nil {
+		// Ignore 404 errors (invitation already accepted, cancelled or removed)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Revoke Team Invitation",
+			fmt.Sprintf("Unable to revoke invitation with ID %s; %v", data.ID.ValueString(), err),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamInvitationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+// ImportState imports a pending invitation using the ID team_id/invitation_id.
+func (r *teamInvitationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, invitationID, ok := strings.Cut(req.ID, "/")
+	if !ok || teamID == "" || invitationID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID in the form team_id/invitation_id, got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), invitationID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+}
+
+// invitationPending reports whether an invitation can still be accepted at now.
+// Accepted and cancelled invitations are no longer active.
+func invitationPending(invitation *contextforge.TeamInvitation, now time.Time) bool {
+	if !invitation.IsActive || invitation.IsExpired {
+		return false
+	}
+	if invitation.ExpiresAt != nil && !invitation.ExpiresAt.Time.IsZero() && !now.Before(invitation.ExpiresAt.Time) {
+		return false
+	}
+	return true
+}
+
+// mapInvitationToState maps SDK TeamInvitation to Terraform state model.
+func (r *teamInvitationResource) mapInvitationToState(invitation *contextforge.TeamInvitation, data *teamInvitationResourceModel) {
+	data.ID = types.StringValue(invitation.ID)
+	data.TeamID = types.StringValue(invitation.TeamID)
+	// Keep the configured spelling when the gateway normalizes the email address
+	if !strings.EqualFold(data.Email.ValueString(), invitation.Email) {
+		data.Email = types.StringValue(invitation.Email)
+	}
+	data.Role = types.StringValue(invitation.Role)
+	data.TeamName = types.StringValue(invitation.TeamName)
+	data.InvitedBy = types.StringValue(invitation.InvitedBy)
+	data.Token = types.StringValue(invitation.Token)
+
+	// The accept endpoint is relative to the client address, which includes the base path
+	acceptURL, err := r.client.Address.Parse(fmt.Sprintf("teams/invitations/%s/accept", url.PathEscape(invitation.Token)))
+	if err == nil && invitation.Token != "" {
+		data.AcceptURL = types.StringValue(acceptURL.String())
+	} else {
+		data.AcceptURL = types.StringNull()
+	}
+
+	// Map timestamps (convert to RFC3339 string)
+	if invitation.InvitedAt != nil && !invitation.InvitedAt.Time.IsZero() {
+		data.InvitedAt = types.StringValue(invitation.InvitedAt.Time.Format(time.RFC3339))
+	} else {
+		data.InvitedAt = types.StringNull()
+	}
+
+	if invitation.ExpiresAt != nil && !invitation.ExpiresAt.Time.IsZero() {
+		data.ExpiresAt = types.StringValue(invitation.ExpiresAt.Time.Format(time.RFC3339))
+	} else {
+		data.ExpiresAt = types.StringNull()
+	}
+}
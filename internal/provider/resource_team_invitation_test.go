@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestAccTeamInvitationResource_basic tests the lifecycle of a team invitation resource.
+// This test verifies:
+//   - Create returns the computed token, accept URL and expiry
+//   - Changing the role replaces the invitation
+//   - An invalid role is rejected before calling the API
+//   - Import using the team_id/invitation_id ID
+//   - Delete revokes the invitation
+//
+// Prerequisites:
+//   - CONTEXTFORGE_ADDR environment variable set
+//   - CONTEXTFORGE_TOKEN environment variable set
+//
+// To run:
+//   make integration-test-all  # Full lifecycle with setup/teardown
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccTeamInvitationResource_basic
+func TestAccTeamInvitationResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamInvitationResourceConfig("member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("contextforge_team_invitation.test", "id"),
+					resource.TestCheckResourceAttr("contextforge_team_invitation.test", "email", "invitee@test.local"),
+					resource.TestCheckResourceAttr("contextforge_team_invitation.test", "role", "member"),
+					resource.TestCheckResourceAttr("contextforge_team_invitation.test", "team_name", "tf-test-team-invitation"),
+					resource.TestCheckResourceAttrSet("contextforge_team_invitation.test", "token"),
+					resource.TestCheckResourceAttrSet("contextforge_team_invitation.test", "accept_url"),
+					resource.TestCheckResourceAttrSet("contextforge_team_invitation.test", "expires_at"),
+				),
+			},
+			// Changing the role replaces the invitation
+			{
+				Config: testAccTeamInvitationResourceConfig("owner"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("contextforge_team_invitation.test", "role", "owner"),
+				),
+			},
+			// An invalid role fails before calling the API
+			{
+				Config:      testAccTeamInvitationResourceConfig("admin"),
+				ExpectError: regexp.MustCompile(`Invalid Team Role`),
+			},
+			// Import testing
+			{
+				ResourceName:      "contextforge_team_invitation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccTeamInvitationResourceImportStateID("contextforge_team_invitation.test"),
+			},
+		},
+	})
+}
+
+// testAccTeamInvitationResourceConfig generates Terraform configuration that creates a
+// team and invites a user without a gateway account to it.
+//
+// Parameters:
+//   - role: Role offered by the invitation (owner or member)
+//
+// Returns:
+//   - HCL configuration string
+func testAccTeamInvitationResourceConfig(role string) string {
+	return fmt.Sprintf(`
+resource "contextforge_team" "test" {
+  name = "tf-test-team-invitation"
+}
+
+resource "contextforge_team_invitation" "test" {
+  team_id = contextforge_team.test.id
+  email   = "invitee@test.local"
+  role    = %[1]q
+}
+`, role)
+}
+
+// testAccTeamInvitationResourceImportStateID returns an ImportStateIdFunc that builds the
+// team_id/invitation_id import ID of an invitation.
+func testAccTeamInvitationResourceImportStateID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return rs.Primary.Attributes["team_id"] + "/" + rs.Primary.ID, nil
+	}
+}
@@ -0,0 +1,460 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+// teamRoles are the roles a team member can hold.
+var teamRoles = []string{"owner", "member"}
+
+type teamMemberResource struct {
+	client   *contextforge.Client
+	readOnly bool
+}
+
+// Force compile-time validation that teamMemberResource satisfies the resource.Resource interface.
+var _ resource.Resource = &teamMemberResource{}
+
+// Force compile-time validation that teamMemberResource satisfies the resource.ResourceWithConfigure interface.
+var _ resource.ResourceWithConfigure = &teamMemberResource{}
+
+// Force compile-time validation that teamMemberResource satisfies the resource.ResourceWithImportState interface.
+var _ resource.ResourceWithImportState = &teamMemberResource{}
+
+// teamMemberResourceModel defines the resource model.
+type teamMemberResourceModel struct {
+	// Computed field (team_id/user_email)
+	ID types.String `tfsdk:"id"`
+
+	// Core fields
+	TeamID    types.String `tfsdk:"team_id"`
+	UserEmail types.String `tfsdk:"user_email"`
+	Role      types.String `tfsdk:"role"`
+
+	// Computed fields
+	MemberID  types.String `tfsdk:"member_id"`
+	InvitedBy types.String `tfsdk:"invited_by"`
+	IsActive  types.Bool   `tfsdk:"is_active"`
+	JoinedAt  types.String `tfsdk:"joined_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewTeamMemberResource is a helper function to instantiate the team member resource.
+func NewTeamMemberResource() resource.Resource {
+	return &teamMemberResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *teamMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *teamMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the membership and role of a user in a ContextForge team",
+		Description:         "Manages the membership and role of a user in a ContextForge team",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed field
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Membership identifier in the form team_id/user_email",
+				Description:         "Membership identifier in the form team_id/user_email",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Core fields
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team ID",
+				Description:         "Team ID",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user",
+				Description:         "Email address of the user",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the user in the team (owner or member, default: member)",
+				Description:         "Role of the user in the team (owner or member, default: member)",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("member"),
+			},
+
+			// Computed fields
+			"member_id": schema.StringAttribute{
+				MarkdownDescription: "Membership record ID",
+				Description:         "Membership record ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invited_by": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user who added or invited the member",
+				Description:         "Email address of the user who added or invited the member",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the membership is active",
+				Description:         "Whether the membership is active",
+				Computed:            true,
+			},
+			"joined_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the user joined the team (RFC3339 format)",
+				Description:         "Timestamp when the user joined the team (RFC3339 format)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *teamMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "team member", &resp.Diagnostics) {
+		return
+	}
+
+	var data teamMemberResourceModel
+
+	// Read plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	teamID := data.TeamID.ValueString()
+	userEmail := data.UserEmail.ValueString()
+
+	role := data.Role.ValueString()
+
+	// Validate the role before adding the member, as the admin endpoint only reports errors as HTML
+	if !validTeamRole(role, &resp.Diagnostics) {
+		return
+	}
+
+	// Existing memberships (such as the team creator's owner membership) must be imported
+	existing, _, err := r.findMember(ctx, teamID, userEmail)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to List Team Members",
+			fmt.Sprintf("Unable to list members of team %s; %v", teamID, err),
+		)
+		return
+	}
+	if existing != nil {
+		resp.Diagnostics.AddError(
+			"Team Member Already Exists",
+			fmt.Sprintf("%s is already a member of team %s. Import it with the ID %s/%s to manage it.", userEmail, teamID, teamID, userEmail),
+		)
+		return
+	}
+
+	// Add the member
+	if _, err := r.addMember(ctx, teamID, userEmail, role); err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Add Team Member",
+			fmt.Sprintf("Unable to add %s to team %s; %v", userEmail, teamID, err),
+		)
+		return
+	}
+
+	// The add-member endpoint returns HTML, so read the membership back
+	member, _, err := r.findMember(ctx, teamID, userEmail)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read Team Member",
+			fmt.Sprintf("Unable to read membership of %s in team %s; %v", userEmail, teamID, err),
+		)
+		return
+	}
+	if member == nil {
+		resp.Diagnostics.AddError(
+			"Team Member Not Found After Create",
+			fmt.Sprintf("Unable to find %s in team %s after adding them", userEmail, teamID),
+		)
+		return
+	}
+
+	// Map response to state
+	mapTeamMemberToState(member, &data)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *teamMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data teamMemberResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	member, httpResp, err := r.findMember(ctx, data.TeamID.ValueString(), data.UserEmail.ValueString())
+	if err != nil {
+		// Handle 404 - team no longer exists
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Read Team Member",
+			fmt.Sprintf("Unable to read membership of %s in team %s; %v", data.UserEmail.ValueString(), data.TeamID.ValueString(), err),
+		)
+		return
+	}
+
+	// User is no longer a member
+	if member == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Map response to state
+	mapTeamMemberToState(member, &data)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *teamMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.readOnly, "update", "team member", &resp.Diagnostics) {
+		return
+	}
+
+	var data teamMemberResourceModel
+
+	// Read plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	teamID := data.TeamID.ValueString()
+	userEmail := data.UserEmail.ValueString()
+
+	role := data.Role.ValueString()
+
+	if !validTeamRole(role, &resp.Diagnostics) {
+		return
+	}
+
+	// Only the role can be changed in place
+	member, _, err := r.client.Teams.UpdateMember(ctx, teamID, userEmail, &contextforge.TeamMemberUpdate{
+		Role: role,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Update Team Member",
+			fmt.Sprintf("Unable to update role of %s in team %s; %v", userEmail, teamID, err),
+		)
+		return
+	}
+
+	// Map response to state
+	mapTeamMemberToState(member, &data)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *teamMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.readOnly, "delete", "team member", &resp.Diagnostics) {
+		return
+	}
+
+	var data teamMemberResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Remove the member
+	httpResp, err := r.client.Teams.RemoveMember(ctx, data.TeamID.ValueString(), data.UserEmail.ValueString())
+	if err != nil {
+		// Ignore 404 errors (member or team already removed)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Remove Team Member",
+			fmt.Sprintf("Unable to remove %s from team %s; %v", data.UserEmail.ValueString(), data.TeamID.ValueString(), err),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *teamMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+// ImportState imports an existing team membership using the ID team_id/user_email.
+func (r *teamMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, userEmail, ok := strings.Cut(req.ID, "/")
+	if !ok || teamID == "" || userEmail == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID in the form team_id/user_email, got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_email"), userEmail)...)
+}
+
+// findMember looks up the membership of userEmail in a team. Returns nil without an
+// error when the user is not a member.
+func (r *teamMemberResource) findMember(ctx context.Context, teamID, userEmail string) (*contextforge.TeamMember, *contextforge.Response, error) {
+	members, httpResp, err := r.client.Teams.ListMembers(ctx, teamID)
+	if err != nil {
+		return nil, httpResp, err
+	}
+
+	for _, m := range members {
+		if strings.EqualFold(m.UserEmail, userEmail) {
+			return m, httpResp, nil
+		}
+	}
+
+	return nil, httpResp, nil
+}
+
+// validTeamRole reports whether role is a team role, adding an attribute error when it is not.
+func validTeamRole(role string, diags *diag.Diagnostics) bool {
+	if slices.Contains(teamRoles, role) {
+		return true
+	}
+
+	diags.AddAttributeError(
+		path.Root("role"),
+		"Invalid Team Role",
+		fmt.Sprintf("The role must be one of %s, got %q.", strings.Join(teamRoles, ", "), role),
+	)
+	return false
+}
+
+// addMember adds an existing user to a team. The REST API only offers invitations for
+// adding members, so the admin API's add-member form endpoint is used; it requires an
+// administrator or team owner and MCPGATEWAY_ADMIN_API_ENABLED on the gateway.
+func (r *teamMemberResource) addMember(ctx context.Context, teamID, userEmail, role string) (*contextforge.Response, error) {
+	return postAdminForm(ctx, r.client, fmt.Sprintf("admin/teams/%s/add-member", url.PathEscape(teamID)), url.Values{
+		"user_email": {userEmail},
+		"role":       {role},
+	})
+}
+
+// mapTeamMemberToState maps SDK TeamMember to Terraform state model.
+func mapTeamMemberToState(member *contextforge.TeamMember, data *teamMemberResourceModel) {
+	data.ID = types.StringValue(data.TeamID.ValueString() + "/" + data.UserEmail.ValueString())
+	data.Role = types.StringValue(member.Role)
+	data.MemberID = types.StringValue(member.ID)
+	data.InvitedBy = types.StringPointerValue(member.InvitedBy)
+	data.IsActive = types.BoolValue(member.IsActive)
+
+	if member.JoinedAt != nil && !member.JoinedAt.Time.IsZero() {
+		data.JoinedAt = types.StringValue(member.JoinedAt.Time.Format(time.RFC3339))
+	} else {
+		data.JoinedAt = types.StringNull()
+	}
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccTeamMemberResource_basic tests the full lifecycle for a team member resource.
+// This test verifies:
+//   - Create adds an existing user to a team with the member role
+//   - Update changes the role in place
+//   - Update rejects a role that is not a team role
+//   - Removing the role from the configuration resets it to member
+//   - Import using the team_id/user_email ID
+//   - Delete removes the user from the team
+//
+// Prerequisites:
+//   - CONTEXTFORGE_ADDR environment variable set
+//   - CONTEXTFORGE_TOKEN environment variable set
+//   - Integration test setup completed (creates the member@test.local user)
+//
+// To run:
+//   make integration-test-all  # Full lifecycle with setup/teardown
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccTeamMemberResource_basic
+func TestAccTeamMemberResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamMemberResourceConfig("member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("contextforge_team_member.test", "team_id", "contextforge_team.test", "id"),
+					resource.TestCheckResourceAttr("contextforge_team_member.test", "user_email", "member@test.local"),
+					resource.TestCheckResourceAttr("contextforge_team_member.test", "role", "member"),
+					resource.TestCheckResourceAttr("contextforge_team_member.test", "is_active", "true"),
+					resource.TestCheckResourceAttrSet("contextforge_team_member.test", "member_id"),
+					resource.TestCheckResourceAttrSet("contextforge_team_member.test", "joined_at"),
+				),
+			},
+			// Update role in place
+			{
+				Config: testAccTeamMemberResourceConfig("owner"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("contextforge_team_member.test", "role", "owner"),
+				),
+			},
+			// Update to an invalid role fails before calling the API
+			{
+				Config:      testAccTeamMemberResourceConfig("admin"),
+				ExpectError: regexp.MustCompile(`Invalid Team Role`),
+			},
+			// Removing the role resets it to the default
+			{
+				Config: testAccTeamMemberResourceConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("contextforge_team_member.test", "role", "member"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:      "contextforge_team_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestAccTeamMemberResource_invalidImportID tests error handling for malformed import IDs.
+//
+// To run:
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccTeamMemberResource_invalidImportID
+func TestAccTeamMemberResource_invalidImportID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:        testAccTeamMemberResourceConfig("member"),
+				ResourceName:  "contextforge_team_member.test",
+				ImportState:   true,
+				ImportStateId: "member@test.local",
+				ExpectError:   regexp.MustCompile(`Invalid Import ID`),
+			},
+		},
+	})
+}
+
+// testAccTeamMemberResourceConfig generates Terraform configuration that creates a team
+// and adds the integration test member user to it.
+//
+// Parameters:
+//   - role: Role of the member in the team (owner or member), or empty to omit it
+//
+// Returns:
+//   - HCL configuration string
+func testAccTeamMemberResourceConfig(role string) string {
+	roleAttr := ""
+	if role != "" {
+		roleAttr = fmt.Sprintf("role       = %q", role)
+	}
+
+	return fmt.Sprintf(`
+resource "contextforge_team" "test" {
+  name = "tf-test-team-member"
+}
+
+resource "contextforge_team_member" "test" {
+  team_id    = contextforge_team.test.id
+  user_email = "member@test.local"
+  %[1]s
+}
+`, roleAttr)
+}
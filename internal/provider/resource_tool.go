@@ -3,8 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,7 +18,11 @@ import (
 )
 
 type toolResource struct {
-	client *contextforge.Client
+	client            *contextforge.Client
+	defaultTags       []string
+	defaultTeamID     string
+	defaultVisibility string
+	readOnly          bool
 }
 
 // Force compile-time validation that toolResource satisfies the resource.Resource interface.
@@ -28,6 +34,9 @@ var _ resource.ResourceWithConfigure = &toolResource{}
 // Force compile-time validation that toolResource satisfies the resource.ResourceWithImportState interface.
 var _ resource.ResourceWithImportState = &toolResource{}
 
+// Force compile-time validation that toolResource satisfies the resource.ResourceWithModifyPlan interface.
+var _ resource.ResourceWithModifyPlan = &toolResource{}
+
 // toolResourceModel defines the resource model.
 type toolResourceModel struct {
 	// Computed field
@@ -41,12 +50,15 @@ type toolResourceModel struct {
 
 	// Organizational fields
 	Tags       types.List   `tfsdk:"tags"`
+	TagsAll    types.List   `tfsdk:"tags_all"`
 	TeamID     types.String `tfsdk:"team_id"`
 	Visibility types.String `tfsdk:"visibility"`
 
 	// Timestamps
 	CreatedAt types.String `tfsdk:"created_at"`
 	UpdatedAt types.String `tfsdk:"updated_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewToolResource is a helper function to instantiate the tool resource.
@@ -124,6 +136,7 @@ func (r *toolResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Optional:            true,
 				Computed:            true,
 			},
+			"tags_all": tagsAllSchemaAttribute(),
 			"team_id": schema.StringAttribute{
 				MarkdownDescription: "Team ID",
 				Description:         "Team ID",
@@ -149,11 +162,34 @@ func (r *toolResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan plans tags_all from the configured tags and the provider default tags, and
+// applies the provider default team and visibility to new tools.
+func (r *toolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanTagsAll(ctx, req, resp, r.defaultTags)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
+	modifyPlanTeamDefaults(ctx, req, resp, r.defaultTeamID, r.defaultVisibility)
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *toolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "tool", &resp.Diagnostics) {
+		return
+	}
+
 	var data toolResourceModel
 
 	// Read plan
@@ -162,6 +198,15 @@ func (r *toolResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Build tool object for API
 	tool := &contextforge.Tool{
 		Name:    data.Name.ValueString(),
@@ -192,14 +237,14 @@ func (r *toolResource) Create(ctx context.Context, req resource.CreateRequest, r
 		tool.InputSchema = schemaMap
 	}
 
-	// Map optional tags
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		var tags []string
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		tool.Tags = contextforge.NewTags(tags)
+	// Map optional tags, merged with the provider default tags
+	tagNames, setTags, tagsDiags := requestTags(ctx, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if setTags {
+		tool.Tags = contextforge.NewTags(tagNames)
 	}
 
 	// Prepare create options for team_id and visibility
@@ -253,13 +298,10 @@ func (r *toolResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	// Map tags from response
-	if createdTool.Tags != nil {
-		tagsList, diags := types.ListValueFrom(ctx, types.StringType, contextforge.TagNames(createdTool.Tags))
-		resp.Diagnostics.Append(diags...)
-		data.Tags = tagsList
-	} else {
-		data.Tags = types.ListNull(types.StringType)
-	}
+	tags, tagsAll, tagsDiags := stateTags(ctx, createdTool.Tags, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	data.Tags = tags
+	data.TagsAll = tagsAll
 
 	data.TeamID = types.StringPointerValue(createdTool.TeamID)
 	data.Visibility = types.StringValue(createdTool.Visibility)
@@ -291,6 +333,15 @@ func (r *toolResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get tool from API
 	tool, httpResp, err := r.client.Tools.Get(ctx, data.ID.ValueString())
 	if err != nil {
@@ -329,13 +380,10 @@ func (r *toolResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Map tags
-	if tool.Tags != nil {
-		tagsList, diags := types.ListValueFrom(ctx, types.StringType, contextforge.TagNames(tool.Tags))
-		resp.Diagnostics.Append(diags...)
-		data.Tags = tagsList
-	} else {
-		data.Tags = types.ListNull(types.StringType)
-	}
+	tags, tagsAll, tagsDiags := stateTags(ctx, tool.Tags, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	data.Tags = tags
+	data.TagsAll = tagsAll
 
 	data.TeamID = types.StringPointerValue(tool.TeamID)
 	data.Visibility = types.StringValue(tool.Visibility)
@@ -359,6 +407,10 @@ func (r *toolResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *toolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.readOnly, "update", "tool", &resp.Diagnostics) {
+		return
+	}
+
 	var data toolResourceModel
 
 	// Read plan
@@ -367,6 +419,15 @@ func (r *toolResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Build tool object for API
 	tool := &contextforge.Tool{
 		Name:    data.Name.ValueString(),
@@ -397,14 +458,25 @@ func (r *toolResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		tool.InputSchema = schemaMap
 	}
 
-	// Map optional tags
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		var tags []string
-		resp.Diagnostics.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
-		if resp.Diagnostics.HasError() {
+	// Map optional tags, merged with the provider default tags
+	tagNames, setTags, tagsDiags := requestTags(ctx, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if setTags {
+		tool.Tags = contextforge.NewTags(tagNames)
+	}
+
+	// An empty tag list is left out of the update request, so the tags are cleared first
+	if setTags && len(tagNames) == 0 {
+		if err := clearTags(ctx, r.client, "tools/"+url.PathEscape(data.ID.ValueString())); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Clear Tags",
+				fmt.Sprintf("Unable to clear tags of tool with ID %s; %v", data.ID.ValueString(), err),
+			)
 			return
 		}
-		tool.Tags = contextforge.NewTags(tags)
 	}
 
 	// Map optional team_id (Update uses tool struct directly)
@@ -461,13 +533,10 @@ func (r *toolResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	// Map tags from response
-	if updatedTool.Tags != nil {
-		tagsList, diags := types.ListValueFrom(ctx, types.StringType, contextforge.TagNames(updatedTool.Tags))
-		resp.Diagnostics.Append(diags...)
-		data.Tags = tagsList
-	} else {
-		data.Tags = types.ListNull(types.StringType)
-	}
+	tags, tagsAll, tagsDiags := stateTags(ctx, updatedTool.Tags, data.Tags, r.defaultTags)
+	resp.Diagnostics.Append(tagsDiags...)
+	data.Tags = tags
+	data.TagsAll = tagsAll
 
 	data.TeamID = types.StringPointerValue(updatedTool.TeamID)
 	data.Visibility = types.StringValue(updatedTool.Visibility)
@@ -491,6 +560,10 @@ func (r *toolResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *toolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.readOnly, "delete", "tool", &resp.Diagnostics) {
+		return
+	}
+
 	var data toolResourceModel
 
 	// Read current state
@@ -499,6 +572,15 @@ func (r *toolResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete tool via API
 	httpResp, err := r.client.Tools.Delete(ctx, data.ID.ValueString())
 	if err != nil {
@@ -523,17 +605,21 @@ func (r *toolResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	}
 
 	// Type assert the provider data to the expected client type
-	client, ok := req.ProviderData.(*contextforge.Client)
+	data, ok := req.ProviderData.(*providerData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *contextforge.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
 	// Assign the client to the resource
-	r.client = client
+	r.client = data.Client
+	r.defaultTags = data.DefaultTags
+	r.defaultTeamID = data.DefaultTeamID
+	r.defaultVisibility = data.DefaultVisibility
+	r.readOnly = data.ReadOnly
 }
 
 // ImportState imports an existing resource by ID.
@@ -0,0 +1,686 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/leefowlercu/go-contextforge/contextforge"
+)
+
+// userDeletionModes are the supported values of deletion_mode. The first is the default.
+var userDeletionModes = []string{"delete", "deactivate"}
+
+// emailUser is an email-auth user account as returned by the /auth/email/admin/users
+// endpoints, which the SDK does not cover.
+type emailUser struct {
+	Email         string                  `json:"email"`
+	FullName      *string                 `json:"full_name"`
+	IsAdmin       bool                    `json:"is_admin"`
+	IsActive      bool                    `json:"is_active"`
+	AuthProvider  string                  `json:"auth_provider"`
+	EmailVerified bool                    `json:"email_verified"`
+	CreatedAt     *contextforge.Timestamp `json:"created_at"`
+	LastLogin     *contextforge.Timestamp `json:"last_login"`
+}
+
+// emailUserRequest is the request body for creating and updating a user. The gateway
+// requires the password on updates as well.
+type emailUserRequest struct {
+	Email    string  `json:"email"`
+	Password string  `json:"password"`
+	FullName *string `json:"full_name,omitempty"`
+}
+
+type userResource struct {
+	client   *contextforge.Client
+	readOnly bool
+}
+
+// Force compile-time validation that userResource satisfies the resource.Resource interface.
+var _ resource.Resource = &userResource{}
+
+// Force compile-time validation that userResource satisfies the resource.ResourceWithConfigure interface.
+var _ resource.ResourceWithConfigure = &userResource{}
+
+// Force compile-time validation that userResource satisfies the resource.ResourceWithImportState interface.
+var _ resource.ResourceWithImportState = &userResource{}
+
+// userResourceModel defines the resource model.
+type userResourceModel struct {
+	// Computed field (the email address)
+	ID types.String `tfsdk:"id"`
+
+	// Core fields
+	Email    types.String `tfsdk:"email"`
+	FullName types.String `tfsdk:"full_name"`
+	Password types.String `tfsdk:"password"`
+	IsAdmin  types.Bool   `tfsdk:"is_admin"`
+	IsActive types.Bool   `tfsdk:"is_active"`
+
+	// Provider-side password rotation trigger
+	PasswordVersion types.String `tfsdk:"password_version"`
+
+	// Provider-side setting
+	DeletionMode types.String `tfsdk:"deletion_mode"`
+
+	// Computed fields
+	AuthProvider  types.String `tfsdk:"auth_provider"`
+	EmailVerified types.Bool   `tfsdk:"email_verified"`
+
+	// Timestamps
+	CreatedAt types.String `tfsdk:"created_at"`
+	LastLogin types.String `tfsdk:"last_login"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewUserResource is a helper function to instantiate the user resource.
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *userResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the resource.
+func (r *userResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a ContextForge email-auth user account",
+		Description:         "Manages a ContextForge email-auth user account",
+
+		Attributes: map[string]schema.Attribute{
+			// Computed field
+			"id": schema.StringAttribute{
+				MarkdownDescription: "User identifier (the email address)",
+				Description:         "User identifier (the email address)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Core fields
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user",
+				Description:         "Email address of the user",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "Full name of the user",
+				Description:         "Full name of the user",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password of the user. Write-only: it is never stored in the Terraform state and is only sent when the user is created or `password_version` changes. Requires Terraform 1.11 or later.",
+				Description:         "Password of the user. Write-only: it is never stored in the Terraform state and is only sent when the user is created or password_version changes. Requires Terraform 1.11 or later.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_version": schema.StringAttribute{
+				MarkdownDescription: "Arbitrary value that is stored in the state; changing it sends the configured `password` to the gateway, rotating the password",
+				Description:         "Arbitrary value that is stored in the state; changing it sends the configured password to the gateway, rotating the password",
+				Optional:            true,
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is a platform administrator (default: false)",
+				Description:         "Whether the user is a platform administrator (default: false)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the account is active (default: true)",
+				Description:         "Whether the account is active (default: true)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Provider-side setting
+			"deletion_mode": schema.StringAttribute{
+				MarkdownDescription: "What destroying the resource does to the account: `delete` removes it, `deactivate` only deactivates it (default: delete)",
+				Description:         "What destroying the resource does to the account: delete removes it, deactivate only deactivates it (default: delete)",
+				Optional:            true,
+			},
+
+			// Computed fields
+			"auth_provider": schema.StringAttribute{
+				MarkdownDescription: "Authentication provider of the account",
+				Description:         "Authentication provider of the account",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email_verified": schema.BoolAttribute{
+				MarkdownDescription: "Whether the email address has been verified",
+				Description:         "Whether the email address has been verified",
+				Computed:            true,
+			},
+
+			// Timestamps
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation timestamp (RFC3339 format)",
+				Description:         "Creation timestamp (RFC3339 format)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_login": schema.StringAttribute{
+				MarkdownDescription: "Last login timestamp (RFC3339 format)",
+				Description:         "Last login timestamp (RFC3339 format)",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if checkReadOnly(r.readOnly, "create", "user", &resp.Diagnostics) {
+		return
+	}
+
+	var data userResourceModel
+
+	// Read plan
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !validUserDeletionMode(data.DeletionMode, &resp.Diagnostics) {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// The password is write-only, so it is only available in the configuration
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := data.Email.ValueString()
+
+	// Create the user
+	created, _, err := r.sendUser(ctx, http.MethodPost, "auth/email/admin/users", userRequestFromPlan(&data, password.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Create User",
+			fmt.Sprintf("Unable to create user %s; %v", email, err),
+		)
+		return
+	}
+
+	// Save the created user before applying the flags, so that a failure below leaves it in the
+	// state instead of orphaning the account
+	planned := data
+	mapUserToState(created, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IsAdmin = planned.IsAdmin
+	data.IsActive = planned.IsActive
+
+	// The REST API creates regular, active users; apply the remaining flags through the admin API
+	if !data.IsAdmin.IsNull() && !data.IsAdmin.IsUnknown() && data.IsAdmin.ValueBool() != created.IsAdmin {
+		if _, err := r.setAdmin(ctx, email, created.FullName, data.IsAdmin.ValueBool()); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Update User",
+				fmt.Sprintf("Unable to set administrator status of user %s; %v", email, err),
+			)
+			return
+		}
+	}
+
+	if !data.IsActive.IsNull() && !data.IsActive.IsUnknown() && data.IsActive.ValueBool() != created.IsActive {
+		if _, err := r.setActive(ctx, email, data.IsActive.ValueBool()); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Update User",
+				fmt.Sprintf("Unable to set active status of user %s; %v", email, err),
+			)
+			return
+		}
+	}
+
+	user, _, err := getUser(ctx, r.client, email)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read User",
+			fmt.Sprintf("Unable to read user %s after creating it; %v", email, err),
+		)
+		return
+	}
+
+	if !checkUserFlags(user, &data, &resp.Diagnostics) {
+		return
+	}
+
+	// Map response to state
+	mapUserToState(user, &data)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data userResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	user, httpResp, err := getUser(ctx, r.client, data.Email.ValueString())
+	if err != nil {
+		// Handle 404 - resource no longer exists
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Read User",
+			fmt.Sprintf("Unable to read user %s; %v", data.Email.ValueString(), err),
+		)
+		return
+	}
+
+	// Map response to state
+	mapUserToState(user, &data)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if checkReadOnly(r.readOnly, "update", "user", &resp.Diagnostics) {
+		return
+	}
+
+	var data, state userResourceModel
+
+	// Read plan and current state
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !validUserDeletionMode(data.DeletionMode, &resp.Diagnostics) {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// The password is write-only, so it is only available in the configuration
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	email := data.Email.ValueString()
+
+	// The password is rotated by changing password_version, as a write-only value cannot be
+	// compared with the previous one. The REST API requires the password on every update, so
+	// it is only used for a rotation, which also sets the full name.
+	passwordChanged := !data.PasswordVersion.Equal(state.PasswordVersion)
+	if passwordChanged {
+		if _, _, err := r.sendUser(ctx, http.MethodPut, "auth/email/admin/users/"+url.PathEscape(email), userRequestFromPlan(&data, password.ValueString())); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Update User",
+				fmt.Sprintf("Unable to update user %s; %v", email, err),
+			)
+			return
+		}
+	}
+
+	// Administrator status is only exposed by the admin API, whose user update form sets the
+	// full name as well; it is used for full name changes without a password rotation
+	isAdmin := state.IsAdmin
+	if !data.IsAdmin.IsUnknown() {
+		isAdmin = data.IsAdmin
+	}
+	if !isAdmin.Equal(state.IsAdmin) || (!passwordChanged && !data.FullName.Equal(state.FullName)) {
+		if _, err := r.setAdmin(ctx, email, data.FullName.ValueStringPointer(), isAdmin.ValueBool()); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Update User",
+				fmt.Sprintf("Unable to set full name and administrator status of user %s; %v", email, err),
+			)
+			return
+		}
+	}
+
+	if !data.IsActive.IsUnknown() && !data.IsActive.Equal(state.IsActive) {
+		if _, err := r.setActive(ctx, email, data.IsActive.ValueBool()); err != nil {
+			resp.Diagnostics.AddError(
+				"Failed to Update User",
+				fmt.Sprintf("Unable to set active status of user %s; %v", email, err),
+			)
+			return
+		}
+	}
+
+	user, _, err := getUser(ctx, r.client, email)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Read User",
+			fmt.Sprintf("Unable to read user %s after updating it; %v", email, err),
+		)
+		return
+	}
+
+	if !checkUserFlags(user, &data, &resp.Diagnostics) {
+		return
+	}
+
+	// Map response to state
+	mapUserToState(user, &data)
+
+	// Save to state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete deletes or deactivates the user, depending on deletion_mode, and removes the
+// Terraform state on success.
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if checkReadOnly(r.readOnly, "delete", "user", &resp.Diagnostics) {
+		return
+	}
+
+	var data userResourceModel
+
+	// Read current state
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	email := data.Email.ValueString()
+
+	if data.DeletionMode.ValueString() == "deactivate" {
+		httpResp, err := r.setActive(ctx, email, false)
+		if err != nil {
+			// Ignore 404 errors (resource already deleted)
+			if httpResp != nil && httpResp.StatusCode == 404 {
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Failed to Deactivate User",
+				fmt.Sprintf("Unable to deactivate user %s; %v", email, err),
+			)
+		}
+		return
+	}
+
+	// Delete the user
+	httpReq, err := r.client.NewRequest(http.MethodDelete, "auth/email/admin/users/"+url.PathEscape(email), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Delete User",
+			fmt.Sprintf("Unable to delete user %s; %v", email, err),
+		)
+		return
+	}
+
+	httpResp, err := r.client.Do(ctx, httpReq, nil)
+	if err != nil {
+		// Ignore 404 errors (resource already deleted)
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Failed to Delete User",
+			fmt.Sprintf("Unable to delete user %s; %v", email, err),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *providerData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+	r.readOnly = data.ReadOnly
+}
+
+// ImportState imports an existing user by email address. The password cannot be read
+// back; it is set to the configured value once password_version is changed from null.
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), req.ID)...)
+}
+
+// sendUser sends a create (POST) or update (PUT) request for a user.
+func (r *userResource) sendUser(ctx context.Context, method, urlStr string, body *emailUserRequest) (*emailUser, *contextforge.Response, error) {
+	req, err := r.client.NewRequest(method, urlStr, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user emailUser
+	resp, err := r.client.Do(ctx, req, &user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &user, resp, nil
+}
+
+// setAdmin sets the administrator status of a user through the admin API's user update
+// form, which always sets the full name as well.
+func (r *userResource) setAdmin(ctx context.Context, email string, fullName *string, isAdmin bool) (*contextforge.Response, error) {
+	form := url.Values{}
+	if fullName != nil {
+		form.Set("full_name", *fullName)
+	}
+	if isAdmin {
+		form.Set("is_admin", "on")
+	}
+
+	return postAdminForm(ctx, r.client, fmt.Sprintf("admin/users/%s/update", url.PathEscape(email)), form)
+}
+
+// setActive activates or deactivates a user through the admin API.
+func (r *userResource) setActive(ctx context.Context, email string, isActive bool) (*contextforge.Response, error) {
+	action := "deactivate"
+	if isActive {
+		action = "activate"
+	}
+
+	return postAdminForm(ctx, r.client, fmt.Sprintf("admin/users/%s/%s", url.PathEscape(email), action), nil)
+}
+
+// getUser retrieves a user by email address.
+func getUser(ctx context.Context, client *contextforge.Client, email string) (*emailUser, *contextforge.Response, error) {
+	req, err := client.NewRequest(http.MethodGet, "auth/email/admin/users/"+url.PathEscape(email), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user emailUser
+	resp, err := client.Do(ctx, req, &user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &user, resp, nil
+}
+
+// userRequestFromPlan builds the REST request body for a user from the plan and the
+// configured password.
+func userRequestFromPlan(data *userResourceModel, password string) *emailUserRequest {
+	body := &emailUserRequest{
+		Email:    data.Email.ValueString(),
+		Password: password,
+	}
+	if !data.FullName.IsNull() && !data.FullName.IsUnknown() {
+		body.FullName = data.FullName.ValueStringPointer()
+	}
+	return body
+}
+
+// validUserDeletionMode reports whether deletion_mode is unset or supported, adding an
+// attribute error otherwise.
+func validUserDeletionMode(mode types.String, diags *diag.Diagnostics) bool {
+	if mode.IsNull() || mode.IsUnknown() || slices.Contains(userDeletionModes, mode.ValueString()) {
+		return true
+	}
+
+	diags.AddAttributeError(
+		path.Root("deletion_mode"),
+		"Invalid Deletion Mode",
+		fmt.Sprintf("The deletion mode must be one of %s, got %q.", strings.Join(userDeletionModes, ", "), mode.ValueString()),
+	)
+	return false
+}
+
+// checkUserFlags verifies that the gateway applied the planned administrator and active
+// status. The admin API reports failures (such as demoting the last administrator) only
+// in its HTML responses, so the user is read back and compared instead.
+func checkUserFlags(user *emailUser, data *userResourceModel, diags *diag.Diagnostics) bool {
+	if !data.FullName.IsNull() && !data.FullName.IsUnknown() && (user.FullName == nil || *user.FullName != data.FullName.ValueString()) {
+		diags.AddAttributeError(
+			path.Root("full_name"),
+			"User Not Updated",
+			fmt.Sprintf("The gateway did not set full_name to %q for user %s. Check that MCPGATEWAY_ADMIN_API_ENABLED is set.", data.FullName.ValueString(), user.Email),
+		)
+		return false
+	}
+
+	if !data.IsAdmin.IsNull() && !data.IsAdmin.IsUnknown() && data.IsAdmin.ValueBool() != user.IsAdmin {
+		diags.AddAttributeError(
+			path.Root("is_admin"),
+			"User Not Updated",
+			fmt.Sprintf("The gateway did not set is_admin to %t for user %s. Check that MCPGATEWAY_ADMIN_API_ENABLED is set and that the user is not the last administrator.", data.IsAdmin.ValueBool(), user.Email),
+		)
+		return false
+	}
+
+	if !data.IsActive.IsNull() && !data.IsActive.IsUnknown() && data.IsActive.ValueBool() != user.IsActive {
+		diags.AddAttributeError(
+			path.Root("is_active"),
+			"User Not Updated",
+			fmt.Sprintf("The gateway did not set is_active to %t for user %s. Check that MCPGATEWAY_ADMIN_API_ENABLED is set and that the user is not the last administrator.", data.IsActive.ValueBool(), user.Email),
+		)
+		return false
+	}
+
+	return true
+}
+
+// mapUserToState maps an API user to the Terraform state model. The configured spelling
+// of the email address is kept when the gateway normalizes its case.
+func mapUserToState(user *emailUser, data *userResourceModel) {
+	if !strings.EqualFold(data.Email.ValueString(), user.Email) {
+		data.Email = types.StringValue(user.Email)
+	}
+	data.ID = data.Email
+
+	data.FullName = types.StringPointerValue(user.FullName)
+	data.IsAdmin = types.BoolValue(user.IsAdmin)
+	data.IsActive = types.BoolValue(user.IsActive)
+	data.AuthProvider = types.StringValue(user.AuthProvider)
+	data.EmailVerified = types.BoolValue(user.EmailVerified)
+
+	// Note: Password is write-only and not returned by API - it is never stored in state
+
+	if user.CreatedAt != nil && !user.CreatedAt.Time.IsZero() {
+		data.CreatedAt = types.StringValue(user.CreatedAt.Time.Format(time.RFC3339))
+	} else {
+		data.CreatedAt = types.StringNull()
+	}
+
+	if user.LastLogin != nil && !user.LastLogin.Time.IsZero() {
+		data.LastLogin = types.StringValue(user.LastLogin.Time.Format(time.RFC3339))
+	} else {
+		data.LastLogin = types.StringNull()
+	}
+}
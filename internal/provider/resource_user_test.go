@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccUserResource_basic tests the full lifecycle for a user resource.
+// This test verifies:
+//   - Create with a full name and default flags
+//   - The write-only password is not stored in state
+//   - Update of the full name, administrator and active status in place
+//   - Password rotation by changing password_version
+//   - Import using the email address
+//   - Delete removes the account
+//
+// Prerequisites:
+//   - CONTEXTFORGE_ADDR environment variable set
+//   - CONTEXTFORGE_TOKEN environment variable set
+//   - MCPGATEWAY_ADMIN_API_ENABLED set on the gateway
+//
+// To run:
+//   make integration-test-all  # Full lifecycle with setup/teardown
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccUserResource_basic
+func TestAccUserResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccUserResourceConfig("TF Test User", false, true, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("contextforge_user.test", "password"),
+					resource.TestCheckResourceAttr("contextforge_user.test", "password_version", "1"),
+					resource.TestCheckResourceAttr("contextforge_user.test", "id", "tf-test-user@test.local"),
+					resource.TestCheckResourceAttr("contextforge_user.test", "email", "tf-test-user@test.local"),
+					resource.TestCheckResourceAttr("contextforge_user.test", "full_name", "TF Test User"),
+					resource.TestCheckResourceAttr("contextforge_user.test", "is_admin", "false"),
+					resource.TestCheckResourceAttr("contextforge_user.test", "is_active", "true"),
+					resource.TestCheckResourceAttrSet("contextforge_user.test", "auth_provider"),
+					resource.TestCheckResourceAttrSet("contextforge_user.test", "created_at"),
+				),
+			},
+			// Update testing
+			{
+				Config: testAccUserResourceConfig("TF Test User Updated", true, false, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("contextforge_user.test", "full_name", "TF Test User Updated"),
+					resource.TestCheckResourceAttr("contextforge_user.test", "is_admin", "true"),
+					resource.TestCheckResourceAttr("contextforge_user.test", "is_active", "false"),
+				),
+			},
+			// Password rotation testing
+			{
+				Config: testAccUserResourceConfig("TF Test User Updated", true, false, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("contextforge_user.test", "password"),
+					resource.TestCheckResourceAttr("contextforge_user.test", "password_version", "2"),
+				),
+			},
+			// Import testing
+			{
+				ResourceName:            "contextforge_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_version"}, // password_version is provider-side only
+			},
+		},
+	})
+}
+
+// TestAccUserResource_invalidDeletionMode tests that an unsupported deletion_mode is rejected.
+//
+// To run:
+//   TF_ACC=1 go test -v ./internal/provider/ -run TestAccUserResource_invalidDeletionMode
+func TestAccUserResource_invalidDeletionMode(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "contextforge_user" "test" {
+  email         = "tf-test-user-invalid@test.local"
+  password      = "testpassword123"
+  deletion_mode = "archive"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Deletion Mode`),
+			},
+		},
+	})
+}
+
+// testAccUserResourceConfig generates Terraform configuration for a user resource.
+//
+// Parameters:
+//   - fullName: Full name of the user
+//   - isAdmin: Whether the user is an administrator
+//   - isActive: Whether the account is active
+//   - passwordVersion: Password rotation trigger
+//
+// Returns:
+//   - HCL configuration string
+func testAccUserResourceConfig(fullName string, isAdmin, isActive bool, passwordVersion string) string {
+	return fmt.Sprintf(`
+resource "contextforge_user" "test" {
+  email            = "tf-test-user@test.local"
+  password         = "testpassword%[4]s"
+  password_version = %[4]q
+  full_name        = %[1]q
+  is_admin         = %[2]t
+  is_active        = %[3]t
+}
+`, fullName, isAdmin, isActive, passwordVersion)
+}
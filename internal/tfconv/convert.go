@@ -7,6 +7,8 @@
 // Key functions:
 //   - ConvertMapToObjectValue: Converts map[string]any to attr.Value for types.Dynamic
 //   - ConvertToAttrValue: Recursively converts Go values to attr.Value types
+//   - ConvertObjectValueToMap: Converts an attr.Value object back to map[string]any
+//   - ConvertAttrValueToAny: Recursively converts attr.Value types to Go values
 //   - Int64Ptr: Converts int to *int64 pointer
 package tfconv
 
@@ -18,6 +20,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // ConvertMapToObjectValue converts a Go map[string]any to an attr.Value for use with types.Dynamic.
@@ -116,7 +119,8 @@ func ConvertToAttrValue(v any) (attr.Value, error) {
 // This is the reverse of ConvertMapToObjectValue and is used when preparing data for API calls.
 //
 // The function handles the conversion of Terraform's type system back to JSON-like structures
-// that can be sent to APIs.
+// that can be sent to APIs. Returns nil for a null or unknown value, and an error if the value
+// is not an object or map.
 //
 // Example usage:
 //
@@ -126,18 +130,94 @@ func ConvertToAttrValue(v any) (attr.Value, error) {
 //	}
 //	tool.InputSchema = schemaMap
 func ConvertObjectValueToMap(ctx context.Context, v attr.Value) (map[string]any, error) {
-	// Convert to JSON to normalize the structure
-	jsonData, err := json.Marshal(v)
+	// attr.Value types have no JSON representation of their own, so convert them recursively
+	result, err := ConvertAttrValueToAny(ctx, v)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal attr.Value; %w", err)
+		return nil, err
 	}
 
-	// Parse back to map[string]any
-	var result map[string]any
-	if err := json.Unmarshal(jsonData, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal to map; %w", err)
+	if result == nil {
+		return nil, nil
 	}
 
+	m, ok := result.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("expected an object value, got %T", v)
+	}
+
+	return m, nil
+}
+
+// ConvertAttrValueToAny recursively converts attr.Value types to Go values.
+// This is the reverse of ConvertToAttrValue.
+//
+// Supports the following type conversions:
+//   - null or unknown values -> nil
+//   - types.Dynamic -> the conversion of its underlying value
+//   - types.String -> string
+//   - types.Bool -> bool
+//   - types.Number, types.Int64, types.Float64 -> float64
+//   - types.List, types.Set, types.Tuple -> []any (recursively converts elements)
+//   - types.Object, types.Map -> map[string]any (recursively converts values)
+//
+// Returns an error if the value type is unsupported or if conversion fails.
+func ConvertAttrValueToAny(ctx context.Context, v attr.Value) (any, error) {
+	if v == nil || v.IsNull() || v.IsUnknown() {
+		return nil, nil
+	}
+
+	switch val := v.(type) {
+	case basetypes.DynamicValue:
+		return ConvertAttrValueToAny(ctx, val.UnderlyingValue())
+	case basetypes.StringValue:
+		return val.ValueString(), nil
+	case basetypes.BoolValue:
+		return val.ValueBool(), nil
+	case basetypes.NumberValue:
+		f, _ := val.ValueBigFloat().Float64()
+		return f, nil
+	case basetypes.Int64Value:
+		return float64(val.ValueInt64()), nil
+	case basetypes.Float64Value:
+		return val.ValueFloat64(), nil
+	case basetypes.ListValue:
+		return convertAttrValuesToSlice(ctx, val.Elements())
+	case basetypes.SetValue:
+		return convertAttrValuesToSlice(ctx, val.Elements())
+	case basetypes.TupleValue:
+		return convertAttrValuesToSlice(ctx, val.Elements())
+	case basetypes.ObjectValue:
+		return convertAttrValuesToMap(ctx, val.Attributes())
+	case basetypes.MapValue:
+		return convertAttrValuesToMap(ctx, val.Elements())
+	default:
+		return nil, fmt.Errorf("unsupported type: %T", v)
+	}
+}
+
+// convertAttrValuesToSlice converts the elements of a list, set or tuple value.
+func convertAttrValuesToSlice(ctx context.Context, elems []attr.Value) ([]any, error) {
+	result := make([]any, len(elems))
+	for i, elem := range elems {
+		v, err := ConvertAttrValueToAny(ctx, elem)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = v
+	}
+	return result, nil
+}
+
+// convertAttrValuesToMap converts the attributes of an object value or the elements of a map value.
+func convertAttrValuesToMap(ctx context.Context, attrs map[string]attr.Value) (map[string]any, error) {
+	result := make(map[string]any, len(attrs))
+	for k, attrVal := range attrs {
+		v, err := ConvertAttrValueToAny(ctx, attrVal)
+		if err != nil {
+			return nil, err
+		}
+		result[k] = v
+	}
 	return result, nil
 }
 
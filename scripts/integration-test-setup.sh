@@ -94,6 +94,7 @@ echo "   ContextForge Address: http://localhost:8000"
 echo "   ContextForge PID: $GATEWAY_PID"
 echo "   Admin Email: admin@test.local"
 echo "   Admin Password: testpassword123"
+echo "   Test Member Email: member@test.local"
 echo "   JWT Token File: $PROJECT_ROOT/tmp/contextforge-test-token.txt"
 echo "   MCP Time Server: http://localhost:8002"
 echo "   Time Server PID File: $PROJECT_ROOT/tmp/time-server.pid"
@@ -326,6 +327,32 @@ else
 fi
 echo ""
 
+# Create test member user
+echo "🔧 Creating test member user..."
+USER_RESPONSE=$(curl -s -X POST http://localhost:8000/auth/email/admin/users \
+  -H "Authorization: Bearer $TOKEN" \
+  -H "Content-Type: application/json" \
+  -d '{
+    "email": "member@test.local",
+    "password": "testpassword123",
+    "full_name": "Test Member"
+  }')
+
+if [ $? -eq 0 ]; then
+  USER_EMAIL=$(echo "$USER_RESPONSE" | jq -r '.email // empty')
+
+  if [ -n "$USER_EMAIL" ] && [ "$USER_EMAIL" != "null" ]; then
+    echo "✅ Test member user created successfully"
+    echo "   User Email: $USER_EMAIL"
+  else
+    echo "⚠️  Failed to extract user email from response"
+    echo "   Response: $USER_RESPONSE"
+  fi
+else
+  echo "⚠️  Failed to create test member user"
+fi
+echo ""
+
 # Create test agent
 echo "🔧 Creating test agent..."
 AGENT_RESPONSE=$(curl -s -X POST http://localhost:8000/a2a \